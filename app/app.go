@@ -1,51 +1,106 @@
 package app
 
 import (
+	"encoding/json"
+	"io"
+	"path/filepath"
+
 	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	log "github.com/cometbft/cometbft/libs/log"
 	tmtypes "github.com/cometbft/cometbft/types"
-	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/server/api"
-	"github.com/cosmos/cosmos-sdk/server/config"
-	servertypes "github.com/cosmos/cosmos-sdk/server/types"
-	"github.com/cosmos/cosmos-sdk/x/auth"
-	authmodule "github.com/cosmos/cosmos-sdk/x/auth"
-	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
-	"github.com/cosmos/cosmos-sdk/x/bank"
-	consensusmodule "github.com/cosmos/cosmos-sdk/x/consensus"
-	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
-	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
-	genutilmodule "github.com/cosmos/cosmos-sdk/x/genutil"
-	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
-	"github.com/cosmos/cosmos-sdk/x/staking"
-	"io"
-	"path/filepath"
+	"github.com/spf13/cast"
 
 	// Cosmos SDK
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	nodeservice "github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-
-	bankmodule "github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	authzmodule "github.com/cosmos/cosmos-sdk/x/authz/module"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/capability"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	consensusmodule "github.com/cosmos/cosmos-sdk/x/consensus"
+	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/evidence"
+	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
+	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	genutilmodule "github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramsmodule "github.com/cosmos/cosmos-sdk/x/params"
+	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
-
-	"google.golang.org/grpc"
-
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
+	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	// IBC
+	"github.com/cosmos/ibc-go/v7/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/v7/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibc "github.com/cosmos/ibc-go/v7/modules/core"
+	ibcclient "github.com/cosmos/ibc-go/v7/modules/core/02-client"
+	ibcclientclient "github.com/cosmos/ibc-go/v7/modules/core/02-client/client"
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+	ibckeeper "github.com/cosmos/ibc-go/v7/modules/core/keeper"
+	ibctm "github.com/cosmos/ibc-go/v7/modules/light-clients/07-tendermint"
+	ibctestingtypes "github.com/cosmos/ibc-go/v7/testing/types"
 
 	// 내 모듈
+	consentmodule "doctorium/x/consent"
+	consentkeeper "doctorium/x/consent/keeper"
+	consenttypes "doctorium/x/consent/types"
 	filehashmodule "doctorium/x/filehash"
 	filehashkeeper "doctorium/x/filehash/keeper"
 	filehashtypes "doctorium/x/filehash/types"
@@ -61,9 +116,13 @@ var (
 	// 모듈 계정 퍼미션 (auth module account permissions)
 	maccPerms = map[string][]string{
 		authtypes.FeeCollectorName:     nil,
+		distrtypes.ModuleName:          nil,
+		minttypes.ModuleName:           {authtypes.Minter},
+		govtypes.ModuleName:            {authtypes.Burner},
+		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		filehashtypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
-		stakingtypes.NotBondedPoolName: nil, // <–– 추가
-		stakingtypes.BondedPoolName:    nil, // <–– 추가
+		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
+		stakingtypes.BondedPoolName:    {authtypes.Burner, authtypes.Staking},
 	}
 )
 
@@ -71,9 +130,31 @@ var ModuleBasics = module.NewBasicManager(
 	auth.AppModuleBasic{},
 	bank.AppModuleBasic{},
 	staking.AppModuleBasic{},
+	mint.AppModuleBasic{},
+	distr.AppModuleBasic{},
+	slashing.AppModuleBasic{},
+	evidence.AppModuleBasic{},
+	gov.NewAppModuleBasic(
+		[]govclient.ProposalHandler{
+			paramsclient.ProposalHandler,
+			upgradeclient.LegacyProposalHandler,
+			upgradeclient.LegacyCancelProposalHandler,
+			ibcclientclient.UpdateClientProposalHandler,
+			ibcclientclient.UpgradeProposalHandler,
+		},
+	),
+	upgrade.AppModuleBasic{},
+	crisis.AppModuleBasic{},
+	capability.AppModuleBasic{},
+	ibc.AppModuleBasic{},
+	ibctm.AppModuleBasic{},
+	transfer.AppModuleBasic{},
+	authzmodule.AppModuleBasic{},
+	feegrantmodule.AppModuleBasic{},
 	genutilmodule.AppModuleBasic{},
 	paramsmodule.AppModuleBasic{},
 	filehashmodule.AppModuleBasic{},
+	consentmodule.AppModuleBasic{},
 	consensusmodule.AppModuleBasic{},
 	// + custom modules (예: filehash)
 )
@@ -86,30 +167,90 @@ type EncodingConfig struct {
 	TxConfig          client.TxConfig
 }
 
+var _ servertypes.Application = (*App)(nil)
+
 type App struct {
 	*baseapp.BaseApp
 
+	legacyAmino       *codec.LegacyAmino
+	appCodec          codec.Codec
+	txConfig          client.TxConfig
+	interfaceRegistry codectypes.InterfaceRegistry
+
+	// 스토어 키
+	keys    map[string]*storetypes.KVStoreKey
+	tkeys   map[string]*storetypes.TransientStoreKey
+	memKeys map[string]*storetypes.MemoryStoreKey
+
+	// SDK 모듈 keeper
+	AccountKeeper         authkeeper.AccountKeeper
+	BankKeeper            bankkeeper.Keeper
+	StakingKeeper         *stakingkeeper.Keeper
+	DistrKeeper           distrkeeper.Keeper
+	SlashingKeeper        slashingkeeper.Keeper
+	MintKeeper            mintkeeper.Keeper
+	EvidenceKeeper        evidencekeeper.Keeper
+	GovKeeper             govkeeper.Keeper
+	UpgradeKeeper         *upgradekeeper.Keeper
+	AuthzKeeper           authzkeeper.Keeper
+	FeeGrantKeeper        feegrantkeeper.Keeper
+	ParamsKeeper          paramskeeper.Keeper
+	ConsensusParamsKeeper consensuskeeper.Keeper
+	CapabilityKeeper      *capabilitykeeper.Keeper
+	CrisisKeeper          *crisiskeeper.Keeper
+
+	// IBC keeper
+	IBCKeeper      *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
+	TransferKeeper ibctransferkeeper.Keeper
+
+	// 모듈별 capability scoped keeper
+	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedFileHashKeeper capabilitykeeper.ScopedKeeper
+
 	// filehash 모듈 keeper
 	FileHashKeeper filehashkeeper.Keeper
-	ModuleManager  *module.Manager
+	// consent 모듈 keeper
+	ConsentKeeper consentkeeper.Keeper
+
+	ModuleManager *module.Manager
+	configurator  module.Configurator
 
-	// (원한다면 auth, bank keeper 등도 여기에)
+	// upgrade applied on start, see Upgrade.ApplyOnStart
+	startUpgrade *upgradetypes.Plan
 }
 
-func (a *App) RegisterAPIRoutes(server *api.Server, config config.APIConfig) {
-	a.RegisterAPIRoutes(server, config)
+// RegisterAPIRoutes registers the gRPC-gateway routes of the SDK services and
+// of every module, plus the swagger UI, with the API server.
+func (a *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig) {
+	clientCtx := apiSvr.ClientCtx
+	// tx 서비스 REST 라우트
+	authtx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// CometBFT 쿼리 REST 라우트
+	tmservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// node 서비스 REST 라우트
+	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	// 모듈 REST 라우트 (filehash 포함)
+	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	if err := server.RegisterSwaggerAPI(clientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
+		panic(err)
+	}
 }
 
-func (a *App) RegisterTxService(context client.Context) {
-	a.RegisterTxService(context)
+// RegisterTxService implements the Application.RegisterTxService method.
+func (a *App) RegisterTxService(clientCtx client.Context) {
+	authtx.RegisterTxService(a.BaseApp.GRPCQueryRouter(), clientCtx, a.BaseApp.Simulate, a.interfaceRegistry)
 }
 
-func (a *App) RegisterTendermintService(context client.Context) {
-	a.RegisterTendermintService(context)
+// RegisterTendermintService implements the Application.RegisterTendermintService method.
+func (a *App) RegisterTendermintService(clientCtx client.Context) {
+	tmservice.RegisterTendermintService(clientCtx, a.BaseApp.GRPCQueryRouter(), a.interfaceRegistry, a.Query)
 }
 
-func (a *App) RegisterNodeService(context client.Context) {
-	a.RegisterNodeService(context)
+// RegisterNodeService implements the Application.RegisterNodeService method.
+func (a *App) RegisterNodeService(clientCtx client.Context) {
+	nodeservice.RegisterNodeService(clientCtx, a.BaseApp.GRPCQueryRouter())
 }
 
 // NewApp 생성자
@@ -122,10 +263,13 @@ func NewDoctoriumApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		panic("app home directory not set")
 	}
 
-	var chainID string
-	genPath := filepath.Join(homeDir, "config", "genesis.json")
-	if doc, err := tmtypes.GenesisDocFromFile(genPath); err == nil {
-		chainID = doc.ChainID
+	// --chain-id 옵션이 우선이고, 없으면 genesis 파일에서 읽는다
+	chainID := cast.ToString(opts.Get(flags.FlagChainID))
+	if chainID == "" {
+		genPath := filepath.Join(homeDir, "config", "genesis.json")
+		if doc, err := tmtypes.GenesisDocFromFile(genPath); err == nil {
+			chainID = doc.ChainID
+		}
 	}
 
 	// 1) BaseApp 생성
@@ -135,159 +279,497 @@ func NewDoctoriumApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLat
 		db,
 		encodingConfig.TxConfig.TxDecoder(),
 		baseapp.SetChainID(chainID),
+		baseapp.SetMinGasPrices(cast.ToString(opts.Get(server.FlagMinGasPrices))),
 	)
+	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetInterfaceRegistry(encodingConfig.InterfaceRegistry)
+	bApp.SetTxEncoder(encodingConfig.TxConfig.TxEncoder())
 
 	// 2) 스토어 키 정의
 	keys := sdk.NewKVStoreKeys(
 		authtypes.StoreKey,
 		banktypes.StoreKey,
 		stakingtypes.StoreKey,
+		distrtypes.StoreKey,
+		slashingtypes.StoreKey,
+		minttypes.StoreKey,
+		evidencetypes.StoreKey,
+		govtypes.StoreKey,
+		upgradetypes.StoreKey,
+		authzkeeper.StoreKey,
+		feegrant.StoreKey,
+		capabilitytypes.StoreKey,
+		crisistypes.StoreKey,
+		ibcexported.StoreKey,
+		ibctransfertypes.StoreKey,
 		paramstypes.StoreKey, // ← 파라미터 스토어 키
 		filehashtypes.StoreKey,
+		consenttypes.StoreKey,
 		consensustypes.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(
 		paramstypes.TStoreKey, // ← 파라미터 트랜지언트 스토어 키
+		filehashtypes.TStoreKey,
 	)
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+
+	app := &App{
+		BaseApp:           bApp,
+		legacyAmino:       encodingConfig.Amino,
+		appCodec:          appCodec,
+		txConfig:          encodingConfig.TxConfig,
+		interfaceRegistry: encodingConfig.InterfaceRegistry,
+		keys:              keys,
+		tkeys:             tkeys,
+		memKeys:           memKeys,
+	}
 
 	// 3) Params Keeper
-	paramsKeeper := paramskeeper.NewKeeper(
+	app.ParamsKeeper = paramskeeper.NewKeeper(
 		appCodec,
 		encodingConfig.Amino,
 		keys[paramstypes.StoreKey],
 		tkeys[paramstypes.TStoreKey],
 	)
-	paramsModule := paramsmodule.NewAppModule(paramsKeeper)
 
-	consensusKeeper := consensuskeeper.NewKeeper(
-		encodingConfig.Marshaler,
+	app.ConsensusParamsKeeper = consensuskeeper.NewKeeper(
+		appCodec,
 		keys[consensustypes.StoreKey],
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// BaseApp에 파라미터 저장소(ConsensusParams)로 등록
+	bApp.SetParamStore(&app.ConsensusParamsKeeper)
 
-	// 8) BaseApp에 파라미터 저장소(ConsensusParams)로 등록
-	bApp.SetParamStore(&consensusKeeper)
-
-	bApp.MountKVStores(keys)
-	bApp.MountTransientStores(tkeys)
-
-	if loadLatest {
-		if err := bApp.LoadLatestVersion(); err != nil {
-			panic(err)
-		}
-	}
+	// capability: IBC 포트/채널 소유권 관리. 모든 scoped keeper 생성 후 seal
+	app.CapabilityKeeper = capabilitykeeper.NewKeeper(appCodec, keys[capabilitytypes.StoreKey], memKeys[capabilitytypes.MemStoreKey])
+	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
+	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedFileHashKeeper := app.CapabilityKeeper.ScopeToModule(filehashtypes.ModuleName)
+	app.CapabilityKeeper.Seal()
 
 	// 4) Auth Keeper
-	accountKeeper := authkeeper.NewAccountKeeper(
-		appCodec,                 // 1) codec
-		keys[authtypes.StoreKey], // 2) KVStoreService
-		func() authtypes.AccountI {
-			return &authtypes.BaseAccount{}
-		}, // 3) 계정 생성 함수
-		maccPerms,               // 4) 모듈 계정 권한
-		sdk.Bech32PrefixAccAddr, // 5) Bech32 계정 주소 접두사
-		authtypes.ModuleName,    // 6) 권한자(authority)
+	app.AccountKeeper = authkeeper.NewAccountKeeper(
+		appCodec,                   // 1) codec
+		keys[authtypes.StoreKey],   // 2) KVStoreService
+		authtypes.ProtoBaseAccount, // 3) 계정 생성 함수
+		maccPerms,                  // 4) 모듈 계정 권한
+		sdk.Bech32PrefixAccAddr,    // 5) Bech32 계정 주소 접두사
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(), // 6) 권한자(authority)
 	)
 
-	blockedAddrs := make(map[string]bool)
 	// 5) Bank Keeper
-	bankKeeper := bankkeeper.NewBaseKeeper(
-		appCodec,                 // codec
-		keys[banktypes.StoreKey], // store key
-		accountKeeper,            // auth keeper
-		blockedAddrs,             // blocked module accounts
-		authtypes.NewModuleAddress(filehashtypes.ModuleName).String(), // authority
+	app.BankKeeper = bankkeeper.NewBaseKeeper(
+		appCodec,                        // codec
+		keys[banktypes.StoreKey],        // store key
+		app.AccountKeeper,               // auth keeper
+		app.BlockedModuleAccountAddrs(), // blocked module accounts
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(), // authority
 	)
 
-	stakingkeeper := stakingkeeper.NewKeeper(
+	app.StakingKeeper = stakingkeeper.NewKeeper(
 		appCodec,                    // codec
 		keys[stakingtypes.StoreKey], // store key
-		accountKeeper,               // auth keeper
-		nil,                         // blocked module accounts
-		authtypes.NewModuleAddress(filehashtypes.ModuleName).String(), // authority
+		app.AccountKeeper,           // auth keeper
+		app.BankKeeper,              // bank keeper
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(), // authority
+	)
+
+	app.MintKeeper = mintkeeper.NewKeeper(
+		appCodec,
+		keys[minttypes.StoreKey],
+		app.StakingKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.DistrKeeper = distrkeeper.NewKeeper(
+		appCodec,
+		keys[distrtypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.SlashingKeeper = slashingkeeper.NewKeeper(
+		appCodec,
+		encodingConfig.Amino,
+		keys[slashingtypes.StoreKey],
+		app.StakingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// 스테이킹 훅: 보상 분배(distribution)와 다운타임 슬래싱(slashing)
+	app.StakingKeeper.SetHooks(
+		stakingtypes.NewMultiStakingHooks(app.DistrKeeper.Hooks(), app.SlashingKeeper.Hooks()),
+	)
+
+	// 이중 서명 등 증거 처리
+	evidenceKeeper := evidencekeeper.NewKeeper(
+		appCodec,
+		keys[evidencetypes.StoreKey],
+		app.StakingKeeper,
+		app.SlashingKeeper,
+	)
+	app.EvidenceKeeper = *evidenceKeeper
+
+	// 업그레이드: gov 모듈 계정이 MsgSoftwareUpgrade 권한자
+	skipUpgradeHeights := map[int64]bool{}
+	for _, h := range cast.ToIntSlice(opts.Get(server.FlagUnsafeSkipUpgrades)) {
+		skipUpgradeHeights[int64(h)] = true
+	}
+	app.UpgradeKeeper = upgradekeeper.NewKeeper(
+		skipUpgradeHeights,
+		keys[upgradetypes.StoreKey],
+		appCodec,
+		homeDir,
+		app.BaseApp,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// crisis: 불변식이 깨지면 체인을 멈춘다
+	app.CrisisKeeper = crisiskeeper.NewKeeper(
+		appCodec,
+		keys[crisistypes.StoreKey],
+		cast.ToUint(opts.Get(server.FlagInvCheckPeriod)),
+		app.BankKeeper,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// authz: 병원이 직원 키에 업로드 권한을 위임, feegrant: 기관이 수수료 대납
+	app.AuthzKeeper = authzkeeper.NewKeeper(
+		keys[authzkeeper.StoreKey],
+		appCodec,
+		app.MsgServiceRouter(),
+		app.AccountKeeper,
+	)
+	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, keys[feegrant.StoreKey], app.AccountKeeper)
+
+	// IBC core + ICS20 transfer
+	app.IBCKeeper = ibckeeper.NewKeeper(
+		appCodec,
+		keys[ibcexported.StoreKey],
+		app.GetSubspace(ibcexported.ModuleName),
+		app.StakingKeeper,
+		app.UpgradeKeeper,
+		scopedIBCKeeper,
+	)
+	app.TransferKeeper = ibctransferkeeper.NewKeeper(
+		appCodec,
+		keys[ibctransfertypes.StoreKey],
+		app.GetSubspace(ibctransfertypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		scopedTransferKeeper,
 	)
 
 	// 6) FileHash Keeper
-	fileHashKeeper := filehashkeeper.NewKeeper(
+	app.FileHashKeeper = filehashkeeper.NewKeeper(
 		appCodec,
 		keys[filehashtypes.StoreKey],
-		bankKeeper,
+		tkeys[filehashtypes.TStoreKey],
+		app.BankKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedFileHashKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(), // authority
 	)
 
-	// 7) ModuleManager 설정
-	mm := module.NewManager(
-		// x/auth 모듈
-		authmodule.NewAppModule(
-			appCodec,                       // 1) codec.Codec
-			accountKeeper,                  // 2) keeper.AccountKeeper
-			authsims.RandomGenesisAccounts, // 3) RandomGenesisAccountsFn
-			paramsKeeper.Subspace(authtypes.ModuleName), // 4) Subspace
-		),
+	// Consent Keeper: 동의 대상 문서가 filehash에 등록되어 있는지 확인한다
+	app.ConsentKeeper = consentkeeper.NewKeeper(
+		appCodec,
+		keys[consenttypes.StoreKey],
+		app.FileHashKeeper,
+	)
 
-		// x/bank 모듈 (예시)
-		bankmodule.NewAppModule(
-			appCodec,
-			bankKeeper,
-			accountKeeper,
-			paramsKeeper.Subspace(banktypes.ModuleName),
-		),
+	// IBC 라우터: transfer 포트와 filehash 증명(attestation) 포트
+	ibcRouter := porttypes.NewRouter()
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transfer.NewIBCModule(app.TransferKeeper)).
+		AddRoute(filehashtypes.ModuleName, filehashmodule.NewIBCModule(app.FileHashKeeper))
+	app.IBCKeeper.SetRouter(ibcRouter)
+
+	// 거버넌스: v1 메시지 제안 + v1beta1 레거시 제안 라우터
+	govRouter := govv1beta1.NewRouter()
+	govRouter.AddRoute(govtypes.RouterKey, govv1beta1.ProposalHandler).
+		AddRoute(paramproposal.RouterKey, paramsmodule.NewParamChangeProposalHandler(app.ParamsKeeper)).
+		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+	govKeeper := govkeeper.NewKeeper(
+		appCodec,
+		keys[govtypes.StoreKey],
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.MsgServiceRouter(),
+		govtypes.DefaultConfig(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	govKeeper.SetLegacyRouter(govRouter)
+	app.GovKeeper = *govKeeper.SetHooks(govtypes.NewMultiGovHooks())
 
-		staking.NewAppModule(
-			appCodec,
-			stakingkeeper,
-			accountKeeper,
-			bankKeeper, // staking 모듈은 bankKeeper, accountKeeper 필요
-			paramsKeeper.Subspace(stakingtypes.ModuleName),
+	// 7) ModuleManager 설정
+	app.ModuleManager = module.NewManager(
+		// x/auth 모듈
+		auth.NewAppModule(
+			appCodec,                              // 1) codec.Codec
+			app.AccountKeeper,                     // 2) keeper.AccountKeeper
+			authsims.RandomGenesisAccounts,        // 3) RandomGenesisAccountsFn
+			app.GetSubspace(authtypes.ModuleName), // 4) Subspace
 		),
-		consensusmodule.NewAppModule(appCodec, consensusKeeper),
-		paramsModule,
-
-		genutilmodule.NewAppModule(accountKeeper, stakingkeeper, bApp.DeliverTx, encodingConfig.TxConfig),
-
-		filehashmodule.NewAppModule(fileHashKeeper),
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
+		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
+		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(distrtypes.ModuleName)),
+		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName)),
+		evidence.NewAppModule(app.EvidenceKeeper),
+		gov.NewAppModule(appCodec, &app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(govtypes.ModuleName)),
+		upgrade.NewAppModule(app.UpgradeKeeper),
+		crisis.NewAppModule(app.CrisisKeeper, cast.ToBool(opts.Get(crisis.FlagSkipGenesisInvariants)), app.GetSubspace(crisistypes.ModuleName)),
+		authzmodule.NewAppModule(appCodec, app.AuthzKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
+		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
+		capability.NewAppModule(appCodec, *app.CapabilityKeeper, false),
+		ibc.NewAppModule(app.IBCKeeper),
+		transfer.NewAppModule(app.TransferKeeper),
+		consensusmodule.NewAppModule(appCodec, app.ConsensusParamsKeeper),
+		paramsmodule.NewAppModule(app.ParamsKeeper),
+		genutilmodule.NewAppModule(app.AccountKeeper, app.StakingKeeper, app.BaseApp.DeliverTx, encodingConfig.TxConfig),
+		filehashmodule.NewAppModule(app.FileHashKeeper),
+		consentmodule.NewAppModule(app.ConsentKeeper),
 	)
 
-	mm.SetOrderBeginBlockers(
+	// mint는 distribution보다 먼저 (인플레이션 → fee collector → 분배),
+	// slashing/evidence는 staking 보다 먼저 실행되어야 한다
+	app.ModuleManager.SetOrderBeginBlockers(
+		upgradetypes.ModuleName,
+		capabilitytypes.ModuleName,
+		minttypes.ModuleName,
+		distrtypes.ModuleName,
+		slashingtypes.ModuleName,
+		evidencetypes.ModuleName,
+		stakingtypes.ModuleName,
+		ibcexported.ModuleName,
+		ibctransfertypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
-		stakingtypes.ModuleName,
+		govtypes.ModuleName,
+		authz.ModuleName,
+		feegrant.ModuleName,
 		genutiltypes.ModuleName,
 		consensustypes.ModuleName,
 		paramstypes.ModuleName,
 		filehashtypes.ModuleName,
+		consenttypes.ModuleName,
+		crisistypes.ModuleName,
 	)
-	mm.SetOrderEndBlockers(
+	app.ModuleManager.SetOrderEndBlockers(
+		crisistypes.ModuleName,
+		govtypes.ModuleName,
+		stakingtypes.ModuleName,
+		ibcexported.ModuleName,
+		ibctransfertypes.ModuleName,
+		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
-		stakingtypes.ModuleName,
+		distrtypes.ModuleName,
+		slashingtypes.ModuleName,
+		minttypes.ModuleName,
+		evidencetypes.ModuleName,
+		upgradetypes.ModuleName,
+		authz.ModuleName,
+		feegrant.ModuleName,
 		genutiltypes.ModuleName,
 		consensustypes.ModuleName,
 		paramstypes.ModuleName,
 		filehashtypes.ModuleName,
+		consenttypes.ModuleName,
 	)
-	mm.SetOrderInitGenesis(
+	// capability는 다른 모듈이 capability를 만들기 전에 가장 먼저 초기화하고,
+	// genutil은 gentx 실행을 위해 auth/bank/staking 이후에 초기화해야 하고,
+	// distribution은 staking 훅이 동작하도록 staking 보다 먼저 초기화한다.
+	// crisis는 모든 모듈의 상태가 올라온 뒤 불변식을 검사하도록 마지막에 둔다
+	app.ModuleManager.SetOrderInitGenesis(
+		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
+		distrtypes.ModuleName,
 		stakingtypes.ModuleName,
+		slashingtypes.ModuleName,
+		govtypes.ModuleName,
+		minttypes.ModuleName,
+		ibcexported.ModuleName,
 		genutiltypes.ModuleName,
+		evidencetypes.ModuleName,
+		ibctransfertypes.ModuleName,
+		upgradetypes.ModuleName,
+		authz.ModuleName,
+		feegrant.ModuleName,
 		consensustypes.ModuleName,
 		paramstypes.ModuleName,
 		filehashtypes.ModuleName,
+		consenttypes.ModuleName,
+		crisistypes.ModuleName,
 	)
 
-	// 10) BaseApp 반환
-	return &App{
-		BaseApp:        bApp,
-		FileHashKeeper: fileHashKeeper,
-		ModuleManager:  mm,
+	// 8) Msg/Query 서비스 등록 (BaseApp 라우터로)
+	app.configurator = module.NewConfigurator(appCodec, bApp.MsgServiceRouter(), bApp.GRPCQueryRouter())
+	app.ModuleManager.RegisterServices(app.configurator)
+	app.ModuleManager.RegisterInvariants(app.CrisisKeeper)
+
+	// 업그레이드 핸들러 / 스토어 로더 등록 (로드 전에 설정해야 한다)
+	app.setupUpgradeHandlers()
+	app.setupUpgradeStoreLoaders()
+
+	// 9) 스토어 마운트
+	app.MountKVStores(keys)
+	app.MountTransientStores(tkeys)
+	app.MountMemoryStores(memKeys)
+
+	// 10) ABCI 훅 연결
+	app.SetInitChainer(app.InitChainer)
+	app.SetBeginBlocker(app.BeginBlocker)
+	app.SetEndBlocker(app.EndBlocker)
+
+	// AnteHandler: 서명/시퀀스 검증, 수수료 차감, 차단 계정 업로드 거부
+	anteHandler, err := NewAnteHandler(HandlerOptions{
+		HandlerOptions: ante.HandlerOptions{
+			AccountKeeper:   app.AccountKeeper,
+			BankKeeper:      app.BankKeeper,
+			FeegrantKeeper:  app.FeeGrantKeeper,
+			SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
+			SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
+		},
+		IBCKeeper:      app.IBCKeeper,
+		FileHashKeeper: app.FileHashKeeper,
+	})
+	if err != nil {
+		panic(err)
+	}
+	app.SetAnteHandler(anteHandler)
+
+	app.ScopedIBCKeeper = scopedIBCKeeper
+	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedFileHashKeeper = scopedFileHashKeeper
+
+	// 11) 모든 설정이 끝난 뒤에 로드한다 (로드 시 BaseApp이 seal 됨)
+	if loadLatest {
+		if err := app.LoadLatestVersion(); err != nil {
+			panic(err)
+		}
+	}
+
+	return app
+}
+
+// InitChainer runs the genesis of every module through the module manager.
+func (a *App) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+	var genesisState GenesisState
+	if err := json.Unmarshal(req.AppStateBytes, &genesisState); err != nil {
+		panic(err)
+	}
+	a.UpgradeKeeper.SetModuleVersionMap(ctx, a.ModuleManager.GetVersionMap())
+	return a.ModuleManager.InitGenesis(ctx, a.appCodec, genesisState)
+}
+
+// BeginBlocker runs the BeginBlock logic of every module.
+func (a *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	a.applyStartUpgrade(ctx)
+	return a.ModuleManager.BeginBlock(ctx, req)
+}
+
+// EndBlocker runs the EndBlock logic of every module.
+func (a *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+	return a.ModuleManager.EndBlock(ctx, req)
+}
+
+// ModuleAccountAddrs returns all the app's module account addresses.
+func (a *App) ModuleAccountAddrs() map[string]bool {
+	modAccAddrs := make(map[string]bool)
+	for acc := range maccPerms {
+		modAccAddrs[authtypes.NewModuleAddress(acc).String()] = true
+	}
+	return modAccAddrs
+}
+
+// BlockedModuleAccountAddrs returns the module account addresses that are not
+// allowed to receive external tokens. The gov module account stays open so
+// proposals can be funded.
+func (a *App) BlockedModuleAccountAddrs() map[string]bool {
+	modAccAddrs := a.ModuleAccountAddrs()
+	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	return modAccAddrs
+}
+
+// LoadHeight loads a particular height.
+func (a *App) LoadHeight(height int64) error {
+	return a.LoadVersion(height)
+}
+
+// AppCodec returns the app's codec.
+func (a *App) AppCodec() codec.Codec {
+	return a.appCodec
+}
+
+// LegacyAmino returns the app's legacy amino codec.
+func (a *App) LegacyAmino() *codec.LegacyAmino {
+	return a.legacyAmino
+}
+
+// InterfaceRegistry returns the app's InterfaceRegistry.
+func (a *App) InterfaceRegistry() codectypes.InterfaceRegistry {
+	return a.interfaceRegistry
+}
+
+// GetKey returns the KVStoreKey for the provided store key.
+func (a *App) GetKey(storeKey string) *storetypes.KVStoreKey {
+	return a.keys[storeKey]
+}
+
+// GetSubspace returns a param subspace for a given module name.
+func (a *App) GetSubspace(moduleName string) paramstypes.Subspace {
+	subspace, _ := a.ParamsKeeper.GetSubspace(moduleName)
+	if subspace.Name() == "" {
+		subspace = a.ParamsKeeper.Subspace(moduleName)
 	}
+	return subspace
+}
+
+// GetMemKey returns the MemoryStoreKey for the provided mem key.
+func (a *App) GetMemKey(storeKey string) *storetypes.MemoryStoreKey {
+	return a.memKeys[storeKey]
+}
+
+// The getters below let the app be driven by the ibc-go testing package's
+// in-memory chains (ibctesting.TestingApp).
 
+// GetBaseApp returns the app's BaseApp.
+func (a *App) GetBaseApp() *baseapp.BaseApp {
+	return a.BaseApp
 }
-func (a *App) RegisterGRPCServices(grpcSrv *grpc.Server) {
-	// Msg 서비스 등록
-	filehashtypes.RegisterMsgServer(grpcSrv, a.FileHashKeeper)
-	// Query 서비스 등록
-	filehashtypes.RegisterQueryServer(grpcSrv, a.FileHashKeeper)
+
+// GetStakingKeeper returns the app's staking keeper.
+func (a *App) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return a.StakingKeeper
+}
+
+// GetIBCKeeper returns the app's IBC keeper.
+func (a *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return a.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the capability keeper scoped to IBC core.
+func (a *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return a.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the app's TxConfig.
+func (a *App) GetTxConfig() client.TxConfig {
+	return a.txConfig
 }
@@ -0,0 +1,231 @@
+package app
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	consenttypes "doctorium/x/consent/types"
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+const testChainID = "doctorium-test-1"
+
+func newTestApp(t *testing.T, db dbm.DB) *App {
+	t.Helper()
+	opts := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir(), flags.FlagChainID: testChainID}
+	return NewDoctoriumApp(log.NewNopLogger(), db, nil, true, opts).(*App)
+}
+
+// genesisWithValidator returns the default genesis state with a single bonded
+// validator, which InitChain requires.
+func genesisWithValidator(t *testing.T, app *App) GenesisState {
+	t.Helper()
+	pubKey, err := mock.NewPV().GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 1)})
+
+	senderKey := secp256k1.GenPrivKey()
+	account := authtypes.NewBaseAccount(senderKey.PubKey().Address().Bytes(), senderKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: account.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+
+	genesis, err := simtestutil.GenesisStateWithValSet(app.AppCodec(), NewDefaultGenesisState(app.AppCodec()),
+		valSet, []authtypes.GenesisAccount{account}, balance)
+	require.NoError(t, err)
+	return genesis
+}
+
+// TestAppBoots runs the default genesis, plus the validator InitChain requires,
+// through InitChain, commits the first blocks and exports the resulting state
+// again.
+func TestAppBoots(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := newTestApp(t, db)
+
+	stateBytes, err := json.Marshal(genesisWithValidator(t, app))
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{
+		ChainId:         testChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	require.Equal(t, filehashtypes.DefaultParams(), app.FileHashKeeper.GetParams(ctx))
+	require.True(t, app.FileHashKeeper.IsBound(ctx, filehashtypes.PortID))
+	require.Equal(t, app.ModuleManager.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+
+	// one more block, so BeginBlock and EndBlock run as well; the IBC localhost
+	// client only gets a height in BeginBlock
+	header := tmproto.Header{ChainID: testChainID, Height: 2, Time: time.Now().UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+	require.Equal(t, int64(2), app.LastBlockHeight())
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	var exportedState GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &exportedState))
+	// ibc-go v7.3 exports the localhost connection under an identifier its own
+	// ValidateGenesis rejects, so only the doctorium modules are validated
+	for _, name := range []string{filehashtypes.ModuleName, consenttypes.ModuleName} {
+		require.Contains(t, exportedState, name)
+		basic := ModuleBasics[name].(module.HasGenesisBasics)
+		require.NoError(t, basic.ValidateGenesis(app.AppCodec(), app.GetTxConfig(), exportedState[name]))
+	}
+
+	// the committed state loads again
+	reloaded := newTestApp(t, db)
+	require.Equal(t, int64(2), reloaded.LastBlockHeight())
+}
+
+// TestV2UpgradeStores checks that the v2 upgrade is registered and adds every
+// store the v1 chain did not mount.
+func TestV2UpgradeStores(t *testing.T) {
+	app := newTestApp(t, dbm.NewMemDB())
+	require.True(t, app.UpgradeKeeper.HasHandler("v2"))
+
+	v1Stores := map[string]bool{
+		authtypes.StoreKey:      true,
+		banktypes.StoreKey:      true,
+		stakingtypes.StoreKey:   true,
+		paramstypes.StoreKey:    true,
+		consensustypes.StoreKey: true,
+		filehashtypes.StoreKey:  true,
+	}
+	added := make(map[string]bool)
+	for _, name := range Upgrades[0].StoreUpgrades.Added {
+		require.False(t, v1Stores[name], "%s already existed in v1", name)
+		added[name] = true
+	}
+	for name := range app.keys {
+		require.True(t, v1Stores[name] || added[name], "store %s is neither in v1 nor added by v2", name)
+	}
+}
+
+// TestV2UpgradeFromV1 applies the v2 upgrade to a state holding only what v1
+// wrote, a bonded validator included, and runs the blocks after it: slashing
+// and distribution must know the validator and its delegation, and the staking
+// pools must have their v2 permissions.
+func TestV2UpgradeFromV1(t *testing.T) {
+	db := dbm.NewMemDB()
+	v1 := newTestApp(t, db)
+	stateBytes, err := json.Marshal(genesisWithValidator(t, v1))
+	require.NoError(t, err)
+	v1.InitChain(abci.RequestInitChain{
+		ChainId:         testChainID,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+
+	// v1 상태로 되돌린다: v2에서 추가된 스토어와 모듈 계정을 지우고
+	// filehash에는 v1 루트 키만 남긴다
+	ctx := v1.NewContext(false, tmproto.Header{ChainID: testChainID, Height: 1})
+	wipe := func(key storetypes.StoreKey) {
+		store := ctx.KVStore(key)
+		var keys [][]byte
+		iter := store.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		require.NoError(t, iter.Close())
+		for _, k := range keys {
+			store.Delete(k)
+		}
+	}
+	for _, name := range Upgrades[0].StoreUpgrades.Added {
+		wipe(v1.keys[name])
+	}
+	wipe(v1.keys[filehashtypes.StoreKey])
+	for _, name := range []string{distrtypes.ModuleName, minttypes.ModuleName, govtypes.ModuleName, ibctransfertypes.ModuleName} {
+		if acc := v1.AccountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(name)); acc != nil {
+			v1.AccountKeeper.RemoveAccount(ctx, acc)
+		}
+	}
+	for _, name := range []string{stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName} {
+		acc := v1.AccountKeeper.GetModuleAccount(ctx, name)
+		base := authtypes.NewBaseAccount(acc.GetAddress(), nil, acc.GetAccountNumber(), acc.GetSequence())
+		v1.AccountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(base, name, authtypes.Staking))
+	}
+	validators := v1.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+	delegations := v1.StakingKeeper.GetAllDelegations(ctx)
+	require.Len(t, delegations, 1)
+	hash := strings.Repeat("ab", 32)
+	ctx.KVStore(v1.keys[filehashtypes.StoreKey]).Set([]byte(hash), []byte(delegations[0].DelegatorAddress))
+	v1.Commit()
+
+	// the v2 binary starts for the upgrade at height 2
+	app := newTestApp(t, db)
+	app.startUpgrade = &upgradetypes.Plan{Name: "v2", Height: 2}
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	votes := []abci.VoteInfo{{
+		Validator:       abci.Validator{Address: consAddr, Power: 1},
+		SignedLastBlock: true,
+	}}
+	for height := int64(2); height <= 3; height++ {
+		header := tmproto.Header{ChainID: testChainID, Height: height, Time: time.Now().UTC(), ProposerAddress: consAddr}
+		app.BeginBlock(abci.RequestBeginBlock{Header: header, LastCommitInfo: abci.CommitInfo{Votes: votes}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+	require.Equal(t, int64(3), app.LastBlockHeight())
+
+	ctx = app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	require.Equal(t, int64(2), app.UpgradeKeeper.GetDoneHeight(ctx, "v2"))
+	require.Equal(t, app.ModuleManager.GetVersionMap(), app.UpgradeKeeper.GetModuleVersionMap(ctx))
+
+	info, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.True(t, found)
+	require.Equal(t, int64(2), info.StartHeight)
+
+	valAddr, delAddr := delegations[0].GetValidatorAddr(), delegations[0].GetDelegatorAddr()
+	require.True(t, app.DistrKeeper.HasDelegatorStartingInfo(ctx, valAddr, delAddr))
+	validator := app.StakingKeeper.Validator(ctx, valAddr)
+	endingPeriod := app.DistrKeeper.IncrementValidatorPeriod(ctx, validator)
+	rewards := app.DistrKeeper.CalculateDelegationRewards(ctx, validator, app.StakingKeeper.Delegation(ctx, delAddr, valAddr), endingPeriod)
+	require.False(t, rewards.IsZero())
+
+	for _, name := range []string{stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName} {
+		acc := app.AccountKeeper.GetModuleAccount(ctx, name)
+		require.True(t, acc.HasPermission(authtypes.Burner), name)
+		require.True(t, acc.HasPermission(authtypes.Staking), name)
+	}
+
+	record, found := app.FileHashKeeper.GetFileRecord(ctx, hash)
+	require.True(t, found)
+	require.Equal(t, delegations[0].DelegatorAddress, record.Owner)
+}
@@ -0,0 +1,245 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	consenttypes "doctorium/x/consent/types"
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// Upgrade describes a coordinated chain upgrade. Name must match the name of
+// the MsgSoftwareUpgrade plan approved by governance.
+type Upgrade struct {
+	Name string
+
+	// CreateUpgradeHandler builds the handler run at the upgrade height. When
+	// nil, the in-place store migrations of every module whose consensus
+	// version changed are run.
+	CreateUpgradeHandler func(*App) upgradetypes.UpgradeHandler
+
+	// StoreUpgrades lists the stores added, renamed or deleted by the upgrade.
+	StoreUpgrades storetypes.StoreUpgrades
+
+	// ApplyOnStart applies the upgrade in the block named by upgrade-info.json
+	// when the binary is started for it, instead of waiting for a plan passed
+	// by governance. It is meant for chains that ran without x/upgrade.
+	ApplyOnStart bool
+}
+
+// Upgrades lists every upgrade this binary knows how to perform, oldest first.
+// A new release appends its upgrade here before the proposal goes on chain.
+var Upgrades = []Upgrade{
+	{
+		// v2 brings the v1 chain, which only ran auth, bank, staking, params,
+		// consensus and filehash, to the full module set: the stores of the new
+		// modules are mounted, RunMigrations runs their default genesis, and the
+		// filehash store moves to its v2 layout (Migrate1to2). The existing
+		// validators and delegations are then registered with slashing and
+		// distribution, and the module accounts get their v2 permissions.
+		//
+		// v1 has no x/upgrade, so the upgrade cannot be proposed on chain.
+		// Validators halt v1 with --halt-height H, write upgrade-info.json with
+		// {"name":"v2","height":H+1} into the data directory and start this
+		// binary, which applies the upgrade in block H+1.
+		Name:                 "v2",
+		CreateUpgradeHandler: v2UpgradeHandler,
+		ApplyOnStart:         true,
+		StoreUpgrades: storetypes.StoreUpgrades{
+			Added: []string{
+				distrtypes.StoreKey,
+				slashingtypes.StoreKey,
+				minttypes.StoreKey,
+				evidencetypes.StoreKey,
+				govtypes.StoreKey,
+				upgradetypes.StoreKey,
+				authzkeeper.StoreKey,
+				feegrant.StoreKey,
+				capabilitytypes.StoreKey,
+				crisistypes.StoreKey,
+				ibcexported.StoreKey,
+				ibctransfertypes.StoreKey,
+				consenttypes.StoreKey,
+			},
+		},
+	},
+}
+
+// v2UpgradeHandler runs the migrations of the v2 upgrade. v1 never stored
+// module versions, so without help RunMigrations would take every module for
+// new and run its default genesis over the existing state. The modules v1 ran
+// are recorded at their current versions first, except filehash, which is at
+// version 1 and gets migrated.
+//
+// The default genesis of slashing and distribution knows nothing of the
+// validators and delegations v1 created, while both modules expect the
+// staking hooks to have run for every one of them: without signing infos the
+// slashing BeginBlocker panics on the first vote. The hooks are replayed once
+// the migrations are done. The genesis of crisis asserts every invariant, the
+// distribution ones included, so it is held back until after the replay.
+func v2UpgradeHandler(a *App) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		fromV1 := len(fromVM) == 0
+		if fromV1 {
+			fromVM = a.ModuleManager.GetVersionMap()
+			for name := range fromVM {
+				switch name {
+				case authtypes.ModuleName, banktypes.ModuleName, stakingtypes.ModuleName,
+					paramstypes.ModuleName, consensustypes.ModuleName, genutiltypes.ModuleName:
+				case crisistypes.ModuleName:
+					// crisis의 InitGenesis는 아래에서 훅을 다시 실행한 뒤 직접 돌린다
+				default:
+					delete(fromVM, name)
+				}
+			}
+			fromVM[filehashtypes.ModuleName] = 1
+		}
+		toVM, err := a.ModuleManager.RunMigrations(ctx, a.configurator, fromVM)
+		if err != nil || !fromV1 {
+			return toVM, err
+		}
+
+		if err := a.replayStakingHooks(ctx); err != nil {
+			return nil, err
+		}
+		a.updateModuleAccountPermissions(ctx)
+		a.CrisisKeeper.InitGenesis(ctx, crisistypes.DefaultGenesisState())
+		a.CrisisKeeper.AssertInvariants(ctx)
+		return toVM, nil
+	}
+}
+
+// replayStakingHooks calls the staking hooks for every existing validator and
+// delegation, as if they had been created with slashing and distribution
+// already running.
+func (a *App) replayStakingHooks(ctx sdk.Context) error {
+	hooks := a.StakingKeeper.Hooks()
+	for _, validator := range a.StakingKeeper.GetAllValidators(ctx) {
+		valAddr := validator.GetOperator()
+		if err := hooks.AfterValidatorCreated(ctx, valAddr); err != nil {
+			return fmt.Errorf("validator %s: %w", valAddr, err)
+		}
+		if !validator.IsBonded() {
+			continue
+		}
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			return fmt.Errorf("validator %s: %w", valAddr, err)
+		}
+		if err := hooks.AfterValidatorBonded(ctx, consAddr, valAddr); err != nil {
+			return fmt.Errorf("validator %s: %w", valAddr, err)
+		}
+	}
+
+	for _, delegation := range a.StakingKeeper.GetAllDelegations(ctx) {
+		delAddr, valAddr := delegation.GetDelegatorAddr(), delegation.GetValidatorAddr()
+		if err := hooks.BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
+			return fmt.Errorf("delegation of %s to %s: %w", delAddr, valAddr, err)
+		}
+		if err := hooks.AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
+			return fmt.Errorf("delegation of %s to %s: %w", delAddr, valAddr, err)
+		}
+	}
+	return nil
+}
+
+// updateModuleAccountPermissions rewrites every existing module account with
+// the permissions of maccPerms. Accounts created by v1, such as the staking
+// pools, keep the permissions they were created with otherwise.
+func (a *App) updateModuleAccountPermissions(ctx sdk.Context) {
+	// 맵 순회 순서는 매번 달라지므로 이름순으로 쓴다
+	names := make([]string, 0, len(maccPerms))
+	for name := range maccPerms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		perms := maccPerms[name]
+		acc := a.AccountKeeper.GetAccount(ctx, authtypes.NewModuleAddress(name))
+		if acc == nil {
+			continue
+		}
+		base := authtypes.NewBaseAccount(acc.GetAddress(), acc.GetPubKey(), acc.GetAccountNumber(), acc.GetSequence())
+		a.AccountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(base, name, perms...))
+	}
+}
+
+// defaultUpgradeHandler runs the module store migrations registered through
+// module.Configurator.RegisterMigration.
+func defaultUpgradeHandler(a *App) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		return a.ModuleManager.RunMigrations(ctx, a.configurator, fromVM)
+	}
+}
+
+// setupUpgradeHandlers registers the handler of every known upgrade with the
+// upgrade keeper.
+func (a *App) setupUpgradeHandlers() {
+	for _, u := range Upgrades {
+		create := u.CreateUpgradeHandler
+		if create == nil {
+			create = defaultUpgradeHandler
+		}
+		a.UpgradeKeeper.SetUpgradeHandler(u.Name, create(a))
+	}
+}
+
+// setupUpgradeStoreLoaders applies the store upgrades of the pending upgrade,
+// if any, when the new binary is started at the upgrade height.
+func (a *App) setupUpgradeStoreLoaders() {
+	upgradeInfo, err := a.UpgradeKeeper.ReadUpgradeInfoFromDisk()
+	if err != nil {
+		panic(fmt.Sprintf("failed to read upgrade info from disk: %s", err))
+	}
+	if a.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
+		return
+	}
+
+	for _, u := range Upgrades {
+		if upgradeInfo.Name == u.Name {
+			storeUpgrades := u.StoreUpgrades
+			a.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
+			if u.ApplyOnStart {
+				a.startUpgrade = &upgradetypes.Plan{Name: u.Name, Height: upgradeInfo.Height}
+			}
+		}
+	}
+}
+
+// applyStartUpgrade applies the upgrade the binary was started for when its
+// height is reached and it has not been applied yet. It runs before the
+// BeginBlock of the modules, just like a scheduled plan would.
+func (a *App) applyStartUpgrade(ctx sdk.Context) {
+	plan := a.startUpgrade
+	if plan == nil || ctx.BlockHeight() != plan.Height {
+		return
+	}
+	a.startUpgrade = nil
+	if a.UpgradeKeeper.GetDoneHeight(ctx, plan.Name) != 0 {
+		return
+	}
+	ctx.Logger().Info(fmt.Sprintf("applying upgrade %q at height %d", plan.Name, plan.Height))
+	a.UpgradeKeeper.ApplyUpgrade(ctx, *plan)
+}
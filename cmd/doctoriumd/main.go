@@ -2,8 +2,9 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/spf13/cobra"
@@ -12,6 +13,8 @@ import (
 
 	// Cosmos SDK
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/rpc"
 	sdkserver "github.com/cosmos/cosmos-sdk/server"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
@@ -23,11 +26,10 @@ import (
 	tmdb "github.com/cometbft/cometbft-db"
 	cmtcfg "github.com/cometbft/cometbft/config"
 	tmlog "github.com/cometbft/cometbft/libs/log"
-	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
-	tmtypes "github.com/cometbft/cometbft/types"
 
 	// Your app
 	"doctorium/app"
+	filehashcli "doctorium/x/filehash/client/cli"
 )
 
 func main() {
@@ -41,6 +43,8 @@ func main() {
 		WithTxConfig(enc.TxConfig).
 		WithLegacyAmino(enc.Amino).
 		WithInput(os.Stdin).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithBroadcastMode(flags.BroadcastSync).
 		WithHomeDir(app.DefaultNodeHome).
 		WithViper("DOCTORIUM")
 
@@ -97,10 +101,14 @@ func main() {
 		genutilcli.AddGenesisAccountCmd(app.DefaultNodeHome),
 	)
 
-	// 5) 키/유틸
+	// 5) 키/유틸, tx/query 루트 커맨드
 	rootCmd.AddCommand(
+		rpc.StatusCommand(),
+		queryCommand(),
+		txCommand(),
 		keyscli.Commands(app.DefaultNodeHome),
 		newFixKeyringCmd(), // 별도 파일의 복구 커맨드(중복 정의 금지)
+		filehashcli.GetMerkleCmd(),
 	)
 
 	// 6) Tendermint run/export 커맨드
@@ -131,28 +139,22 @@ func main() {
 			trace io.Writer,
 			height int64,
 			forZeroHeight bool,
-			_ []string,
+			jailAllowedAddrs []string,
 			opts servertypes.AppOptions,
-			_ []string,
+			modulesToExport []string,
 		) (servertypes.ExportedApp, error) {
-			raw := app.NewDoctoriumApp(logger, db, trace, false, opts).(*app.App)
-			ctx := raw.BaseApp.NewContext(forZeroHeight, tmproto.Header{Height: height})
-			state := raw.ModuleManager.ExportGenesis(ctx, enc.Marshaler)
-
-			bz, err := json.MarshalIndent(state, "", "  ")
-			if err != nil {
-				return servertypes.ExportedApp{}, err
+			// height == -1 이면 최신 높이에서 export
+			raw := app.NewDoctoriumApp(logger, db, trace, height == -1, opts).(*app.App)
+			if height != -1 {
+				if err := raw.LoadHeight(height); err != nil {
+					return servertypes.ExportedApp{}, err
+				}
 			}
-			return servertypes.ExportedApp{
-				AppState:        bz,
-				Validators:      []tmtypes.GenesisValidator{},
-				Height:          height,
-				ConsensusParams: &tmproto.ConsensusParams{},
-			}, nil
+			return raw.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
 		},
 
-		// addModuleInitFlags (필요 없으면 no-op)
-		func(cmd *cobra.Command) {},
+		// addModuleInitFlags: crisis 불변식 검사 플래그
+		crisis.AddModuleInitFlags,
 	)
 
 	// 7) 실행
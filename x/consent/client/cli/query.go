@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"doctorium/x/consent/types"
+)
+
+// GetQueryCmd returns the cli query commands for the consent module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the consent module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdShowConsent(),
+		CmdConsentsByPatient(),
+		CmdConsentsByProvider(),
+		CmdConsentsByFile(),
+	)
+	return cmd
+}
+
+// CmdShowConsent shows the consent a patient granted a provider on a document.
+func CmdShowConsent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [patient] [provider] [file-hash]",
+		Short: "Show the consent a patient granted a provider on a document",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Consent(cmd.Context(), &types.QueryConsentRequest{
+				Patient:  args[0],
+				Provider: args[1],
+				FileHash: args[2],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdConsentsByPatient lists the consents granted by a patient.
+func CmdConsentsByPatient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-patient [patient]",
+		Short: "List the consents granted by a patient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConsentsByPatient(cmd.Context(), &types.QueryConsentsByPatientRequest{Patient: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "by-patient")
+	return cmd
+}
+
+// CmdConsentsByProvider lists the consents granted to a provider.
+func CmdConsentsByProvider() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-provider [provider]",
+		Short: "List the consents granted to a provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConsentsByProvider(cmd.Context(), &types.QueryConsentsByProviderRequest{Provider: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "by-provider")
+	return cmd
+}
+
+// CmdConsentsByFile lists the consents given on a document.
+func CmdConsentsByFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-file [file-hash]",
+		Short: "List the consents given on a document",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConsentsByFile(cmd.Context(), &types.QueryConsentsByFileRequest{FileHash: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "by-file")
+	return cmd
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"doctorium/x/consent/types"
+)
+
+const FlagExpiration = "expiration"
+
+// GetTxCmd returns the transaction commands for the consent module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Consent transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdGrantConsent(),
+		CmdRevokeConsent(),
+	)
+	return cmd
+}
+
+// CmdGrantConsent grants a provider consent scopes on a registered document.
+func CmdGrantConsent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [provider] [file-hash] [scope...]",
+		Short: "Grant a provider consent on a registered document",
+		Long: `Grant the provider the given scopes (view, share or research) on a registered
+document, optionally until --expiration (unix timestamp). Granting again
+replaces the previous grant of the same provider on the same document. Only
+the patient the document is bound to, at registration (upload --patient) or
+later (tx filehash bind-patient), can grant consent on it.`,
+		Example: `$ doctoriumd tx consent grant doctorium1... 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 view share --expiration 1767225600 --from patient`,
+		Args:    cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopes, err := parseScopes(args[2:])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgGrantConsent{
+				Patient:  clientCtx.GetFromAddress().String(),
+				Provider: args[0],
+				FileHash: args[1],
+				Scopes:   scopes,
+			}
+			if exp, _ := cmd.Flags().GetInt64(FlagExpiration); exp != 0 {
+				e := time.Unix(exp, 0)
+				if !e.After(time.Now()) {
+					return fmt.Errorf("expiration %s is in the past", e)
+				}
+				msg.Expiration = &e
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(FlagExpiration, 0, "unix timestamp at which the consent lapses")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRevokeConsent withdraws scopes granted to a provider.
+func CmdRevokeConsent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [provider] [file-hash] [scope...]",
+		Short: "Withdraw consent granted to a provider",
+		Long: `Withdraw the given scopes granted to the provider on a document. Without scopes
+the whole consent is withdrawn.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			scopes, err := parseScopes(args[2:])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeConsent{
+				Patient:  clientCtx.GetFromAddress().String(),
+				Provider: args[0],
+				FileHash: args[1],
+				Scopes:   scopes,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseScopes(args []string) ([]types.ConsentScope, error) {
+	scopes := make([]types.ConsentScope, 0, len(args))
+	for _, arg := range args {
+		scope, err := types.ParseConsentScope(arg)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	return scopes, nil
+}
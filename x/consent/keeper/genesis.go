@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"doctorium/x/consent/types"
+)
+
+// InitGenesis stores every consent of the genesis state. Consents that have
+// already expired are pruned at the beginning of the first block.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	for _, consent := range gs.Consents {
+		k.SetConsent(ctx, consent)
+	}
+}
+
+// ExportGenesis returns the consent module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gs := types.DefaultGenesis()
+	k.IterateConsents(ctx, func(consent *types.Consent) bool {
+		gs.Consents = append(gs.Consents, consent)
+		return false
+	})
+	return gs
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"doctorium/x/consent/types"
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// Consent implements the Query/Consent gRPC method.
+func (k Keeper) Consent(goCtx context.Context, req *types.QueryConsentRequest) (*types.QueryConsentResponse, error) {
+	if req == nil || req.FileHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "file hash cannot be empty")
+	}
+	patient, err := sdk.AccAddressFromBech32(req.Patient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid patient address: %s", err)
+	}
+	provider, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider address: %s", err)
+	}
+	hash, err := filehashtypes.NormalizeRegisteredHash(req.FileHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consent, found := k.GetConsent(ctx, patient, provider, hash)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "%s has not granted %s consent on %s", req.Patient, req.Provider, req.FileHash)
+	}
+	return &types.QueryConsentResponse{Consent: consent}, nil
+}
+
+// ConsentsByPatient implements the Query/ConsentsByPatient gRPC method.
+func (k Keeper) ConsentsByPatient(goCtx context.Context, req *types.QueryConsentsByPatientRequest) (*types.QueryConsentsByPatientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	patient, err := sdk.AccAddressFromBech32(req.Patient)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid patient address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PatientConsentsPrefix(patient))
+	resp := &types.QueryConsentsByPatientResponse{}
+	pageRes, err := query.Paginate(consentStore, req.Pagination, func(_ []byte, value []byte) error {
+		var consent types.Consent
+		if err := k.cdc.Unmarshal(value, &consent); err != nil {
+			return err
+		}
+		resp.Consents = append(resp.Consents, &consent)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
+
+// ConsentsByProvider implements the Query/ConsentsByProvider gRPC method.
+func (k Keeper) ConsentsByProvider(goCtx context.Context, req *types.QueryConsentsByProviderRequest) (*types.QueryConsentsByProviderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	provider, err := sdk.AccAddressFromBech32(req.Provider)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid provider address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProviderIndexPrefixFor(provider))
+	resp := &types.QueryConsentsByProviderResponse{}
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		patient, hash := types.ParseProviderIndexKey(key)
+		consent, found := k.GetConsent(ctx, patient, provider, hash)
+		if !found {
+			return status.Errorf(codes.Internal, "indexed consent of %s on %s not found", patient, hash)
+		}
+		resp.Consents = append(resp.Consents, consent)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
+
+// ConsentsByFile implements the Query/ConsentsByFile gRPC method.
+func (k Keeper) ConsentsByFile(goCtx context.Context, req *types.QueryConsentsByFileRequest) (*types.QueryConsentsByFileResponse, error) {
+	if req == nil || req.FileHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "file hash cannot be empty")
+	}
+	// the file index length-prefixes the hash like an address; a normalized
+	// digest is always well below address.MaxAddrLen
+	hash, err := filehashtypes.NormalizeRegisteredHash(req.FileHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FileIndexPrefixFor(hash))
+	resp := &types.QueryConsentsByFileResponse{}
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		patient, provider := types.ParseFileIndexKey(key)
+		consent, found := k.GetConsent(ctx, patient, provider, hash)
+		if !found {
+			return status.Errorf(codes.Internal, "indexed consent of %s to %s not found", patient, provider)
+		}
+		resp.Consents = append(resp.Consents, consent)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"doctorium/x/consent/types"
+)
+
+// Keeper handles storage and business logic for the consent module.
+type Keeper struct {
+	types.UnimplementedMsgServer
+	types.UnimplementedQueryServer
+
+	storeKey       storetypes.StoreKey
+	cdc            codec.BinaryCodec
+	fileHashKeeper types.FileHashKeeper
+}
+
+// NewKeeper creates a new Keeper instance.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	fileHashKeeper types.FileHashKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:       key,
+		cdc:            cdc,
+		fileHashKeeper: fileHashKeeper,
+	}
+}
+
+// HasConsent reports whether patient currently consents to provider using
+// the document hash within scope. Modules gating access to documents call it
+// before acting on behalf of a provider.
+func (k Keeper) HasConsent(ctx sdk.Context, patient, provider sdk.AccAddress, hash string, scope types.ConsentScope) bool {
+	consent, found := k.GetConsent(ctx, patient, provider, hash)
+	if !found || consent.IsExpired(ctx.BlockTime()) {
+		return false
+	}
+	return consent.HasScope(scope)
+}
+
+// GetConsent returns the consent patient granted provider on hash.
+func (k Keeper) GetConsent(ctx sdk.Context, patient, provider sdk.AccAddress, hash string) (*types.Consent, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ConsentKey(patient, provider, hash))
+	if bz == nil {
+		return nil, false
+	}
+	var consent types.Consent
+	k.cdc.MustUnmarshal(bz, &consent)
+	return &consent, true
+}
+
+// SetConsent stores a consent and indexes it by provider, by document and by
+// expiration. A consent it replaces must be deleted first.
+func (k Keeper) SetConsent(ctx sdk.Context, consent *types.Consent) {
+	patient := sdk.MustAccAddressFromBech32(consent.Patient)
+	provider := sdk.MustAccAddressFromBech32(consent.Provider)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsentKey(patient, provider, consent.FileHash), k.cdc.MustMarshal(consent))
+	store.Set(types.ProviderIndexKey(patient, provider, consent.FileHash), []byte{})
+	store.Set(types.FileIndexKey(patient, provider, consent.FileHash), []byte{})
+	if consent.Expiration != nil {
+		store.Set(types.ExpirationQueueKey(*consent.Expiration, patient, provider, consent.FileHash), []byte{})
+	}
+}
+
+// DeleteConsent removes a consent together with its index entries.
+func (k Keeper) DeleteConsent(ctx sdk.Context, consent *types.Consent) {
+	patient := sdk.MustAccAddressFromBech32(consent.Patient)
+	provider := sdk.MustAccAddressFromBech32(consent.Provider)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsentKey(patient, provider, consent.FileHash))
+	store.Delete(types.ProviderIndexKey(patient, provider, consent.FileHash))
+	store.Delete(types.FileIndexKey(patient, provider, consent.FileHash))
+	if consent.Expiration != nil {
+		store.Delete(types.ExpirationQueueKey(*consent.Expiration, patient, provider, consent.FileHash))
+	}
+}
+
+// IterateConsents calls cb for every stored consent in patient order until cb
+// returns true.
+func (k Keeper) IterateConsents(ctx sdk.Context, cb func(consent *types.Consent) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ConsentPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var consent types.Consent
+		k.cdc.MustUnmarshal(iter.Value(), &consent)
+		if cb(&consent) {
+			break
+		}
+	}
+}
+
+// DeleteExpiredConsents removes every consent that has lapsed by the current
+// block time. It runs at the beginning of every block.
+func (k Keeper) DeleteExpiredConsents(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.ExpirationQueueTimePrefix(ctx.BlockTime()))
+	iter := store.Iterator(types.ExpirationQueuePrefix, end)
+
+	// 순회 중에는 스토어를 수정하지 않도록 만료된 동의를 먼저 모은다
+	var expired []*types.Consent
+	for ; iter.Valid(); iter.Next() {
+		bz := store.Get(types.ParseExpirationQueueKey(iter.Key()))
+		if bz == nil {
+			continue
+		}
+		var consent types.Consent
+		k.cdc.MustUnmarshal(bz, &consent)
+		expired = append(expired, &consent)
+	}
+	iter.Close()
+
+	for _, consent := range expired {
+		k.DeleteConsent(ctx, consent)
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeConsentExpired,
+			sdk.NewAttribute(types.AttributeKeyPatient, consent.Patient),
+			sdk.NewAttribute(types.AttributeKeyProvider, consent.Provider),
+			sdk.NewAttribute(types.AttributeKeyFileHash, consent.FileHash),
+		))
+	}
+}
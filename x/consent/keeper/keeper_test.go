@@ -0,0 +1,340 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"doctorium/x/consent/keeper"
+	"doctorium/x/consent/types"
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// fakeFileHash serves file records from a map instead of the filehash store.
+type fakeFileHash map[string]*filehashtypes.FileRecord
+
+func (f fakeFileHash) GetFileRecord(_ sdk.Context, hash string) (*filehashtypes.FileRecord, bool) {
+	record, found := f[hash]
+	return record, found
+}
+
+func setupKeeper(t *testing.T, files fakeFileHash) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	return testCtx.Ctx, keeper.NewKeeper(codec.NewProtoCodec(registry), key, files)
+}
+
+func TestGrantConsentRequiresBoundPatient(t *testing.T) {
+	clinic := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	patient := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	provider := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	var (
+		bound   = strings.Repeat("0a", 32)
+		unbound = strings.Repeat("0b", 32)
+	)
+	ctx, k := setupKeeper(t, fakeFileHash{
+		bound:   {FileHash: bound, Creator: clinic, Owner: clinic, Patient: patient},
+		unbound: {FileHash: unbound, Creator: clinic, Owner: clinic},
+	})
+	goCtx := sdk.WrapSDKContext(ctx)
+	grant := func(signer, hash string) error {
+		_, err := k.GrantConsent(goCtx, &types.MsgGrantConsent{
+			Patient:  signer,
+			Provider: provider,
+			FileHash: hash,
+			Scopes:   []types.ConsentScope{types.ConsentScope_CONSENT_SCOPE_VIEW},
+		})
+		return err
+	}
+
+	// 등록자나 제3자는 환자를 사칭할 수 없다
+	require.ErrorIs(t, grant(provider, bound), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, grant(clinic, bound), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, grant(patient, unbound), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, grant(patient, strings.Repeat("0c", 32)), types.ErrFileNotFound)
+
+	require.NoError(t, grant(patient, strings.ToUpper(bound)))
+	require.True(t, k.HasConsent(ctx, sdk.MustAccAddressFromBech32(patient), sdk.MustAccAddressFromBech32(provider),
+		bound, types.ConsentScope_CONSENT_SCOPE_VIEW))
+
+	res, err := k.ConsentsByFile(goCtx, &types.QueryConsentsByFileRequest{FileHash: bound})
+	require.NoError(t, err)
+	require.Len(t, res.Consents, 1)
+}
+
+func TestConsentsByFileRejectsInvalidHash(t *testing.T) {
+	ctx, k := setupKeeper(t, fakeFileHash{})
+
+	for _, hash := range []string{strings.Repeat("a", 256), strings.Repeat("a", 64) + "0", "not-a-digest"} {
+		_, err := k.ConsentsByFile(sdk.WrapSDKContext(ctx), &types.QueryConsentsByFileRequest{FileHash: hash})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), hash)
+	}
+
+	res, err := k.ConsentsByFile(sdk.WrapSDKContext(ctx), &types.QueryConsentsByFileRequest{FileHash: strings.Repeat("A", 64)})
+	require.NoError(t, err)
+	require.Empty(t, res.Consents)
+}
+
+// consentParties returns the patient and provider addresses used by the
+// consent tests.
+func consentParties() (patients, providers [2]sdk.AccAddress) {
+	for i := range patients {
+		patients[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(0x10 + i)}, 20))
+		providers[i] = sdk.AccAddress(bytes.Repeat([]byte{byte(0x20 + i)}, 20))
+	}
+	return patients, providers
+}
+
+func newConsent(patient, provider sdk.AccAddress, hash string, expiration *time.Time, scopes ...types.ConsentScope) *types.Consent {
+	return &types.Consent{
+		Patient:    patient.String(),
+		Provider:   provider.String(),
+		FileHash:   hash,
+		Scopes:     scopes,
+		Expiration: expiration,
+		BlockTime:  time.Unix(0, 0).UTC(),
+	}
+}
+
+func countEvents(ctx sdk.Context, eventType string) int {
+	var n int
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == eventType {
+			n++
+		}
+	}
+	return n
+}
+
+func TestDeleteExpiredConsents(t *testing.T) {
+	ctx, k := setupKeeper(t, fakeFileHash{})
+	patients, providers := consentParties()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	var (
+		before = now.Add(-time.Second)
+		at     = now
+		after  = now.Add(time.Second)
+	)
+	hashes := map[string]*time.Time{
+		strings.Repeat("a1", 32): &before,
+		strings.Repeat("a2", 32): &at,
+		strings.Repeat("a3", 32): &after,
+		strings.Repeat("a4", 32): nil,
+	}
+	for hash, expiration := range hashes {
+		k.SetConsent(ctx, newConsent(patients[0], providers[0], hash, expiration, types.ConsentScope_CONSENT_SCOPE_VIEW))
+	}
+
+	remaining := func() []string {
+		res, err := k.ConsentsByProvider(sdk.WrapSDKContext(ctx), &types.QueryConsentsByProviderRequest{Provider: providers[0].String()})
+		require.NoError(t, err)
+		var left []string
+		for _, c := range res.Consents {
+			left = append(left, c.FileHash)
+		}
+		return left
+	}
+
+	// 블록 시각과 같거나 이전에 만료된 동의만 지운다
+	ctx = ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+	k.DeleteExpiredConsents(ctx)
+	require.ElementsMatch(t, []string{strings.Repeat("a3", 32), strings.Repeat("a4", 32)}, remaining())
+	require.Equal(t, 2, countEvents(ctx, types.EventTypeConsentExpired))
+	for _, hash := range []string{strings.Repeat("a1", 32), strings.Repeat("a2", 32)} {
+		_, found := k.GetConsent(ctx, patients[0], providers[0], hash)
+		require.False(t, found)
+		res, err := k.ConsentsByFile(sdk.WrapSDKContext(ctx), &types.QueryConsentsByFileRequest{FileHash: hash})
+		require.NoError(t, err)
+		require.Empty(t, res.Consents)
+	}
+
+	// the queue entries of deleted consents are gone as well
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.DeleteExpiredConsents(ctx)
+	require.Zero(t, countEvents(ctx, types.EventTypeConsentExpired))
+
+	ctx = ctx.WithBlockTime(after).WithEventManager(sdk.NewEventManager())
+	k.DeleteExpiredConsents(ctx)
+	require.Equal(t, []string{strings.Repeat("a4", 32)}, remaining())
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeConsentExpired))
+}
+
+func TestRevokeConsent(t *testing.T) {
+	patients, providers := consentParties()
+	patient, provider := patients[0], providers[0]
+	hash := strings.Repeat("b1", 32)
+	ctx, k := setupKeeper(t, fakeFileHash{
+		hash: {FileHash: hash, Creator: providers[1].String(), Owner: providers[1].String(), Patient: patient.String()},
+	})
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	goCtx := sdk.WrapSDKContext(ctx)
+	expiration := now.Add(time.Hour)
+	all := []types.ConsentScope{
+		types.ConsentScope_CONSENT_SCOPE_VIEW, types.ConsentScope_CONSENT_SCOPE_SHARE, types.ConsentScope_CONSENT_SCOPE_RESEARCH,
+	}
+	grant := func() {
+		_, err := k.GrantConsent(goCtx, &types.MsgGrantConsent{
+			Patient: patient.String(), Provider: provider.String(), FileHash: hash, Scopes: all, Expiration: &expiration,
+		})
+		require.NoError(t, err)
+	}
+	revoke := func(scopes ...types.ConsentScope) error {
+		_, err := k.RevokeConsent(goCtx, &types.MsgRevokeConsent{
+			Patient: patient.String(), Provider: provider.String(), FileHash: strings.ToUpper(hash), Scopes: scopes,
+		})
+		return err
+	}
+
+	// 일부 범위만 철회하면 나머지는 만료 시각과 함께 남는다
+	grant()
+	require.NoError(t, revoke(types.ConsentScope_CONSENT_SCOPE_SHARE))
+	consent, found := k.GetConsent(ctx, patient, provider, hash)
+	require.True(t, found)
+	require.Equal(t, []types.ConsentScope{types.ConsentScope_CONSENT_SCOPE_VIEW, types.ConsentScope_CONSENT_SCOPE_RESEARCH}, consent.Scopes)
+	require.Equal(t, expiration, *consent.Expiration)
+	require.False(t, k.HasConsent(ctx, patient, provider, hash, types.ConsentScope_CONSENT_SCOPE_SHARE))
+	require.True(t, k.HasConsent(ctx, patient, provider, hash, types.ConsentScope_CONSENT_SCOPE_VIEW))
+	res, err := k.ConsentsByFile(goCtx, &types.QueryConsentsByFileRequest{FileHash: hash})
+	require.NoError(t, err)
+	require.Len(t, res.Consents, 1)
+
+	// revoking every remaining scope removes the consent and its indexes
+	require.NoError(t, revoke(types.ConsentScope_CONSENT_SCOPE_VIEW, types.ConsentScope_CONSENT_SCOPE_RESEARCH))
+	_, found = k.GetConsent(ctx, patient, provider, hash)
+	require.False(t, found)
+	res, err = k.ConsentsByFile(goCtx, &types.QueryConsentsByFileRequest{FileHash: hash})
+	require.NoError(t, err)
+	require.Empty(t, res.Consents)
+	byProvider, err := k.ConsentsByProvider(goCtx, &types.QueryConsentsByProviderRequest{Provider: provider.String()})
+	require.NoError(t, err)
+	require.Empty(t, byProvider.Consents)
+	require.ErrorIs(t, revoke(), types.ErrConsentNotFound)
+
+	// revoking without scopes removes the whole consent
+	grant()
+	require.NoError(t, revoke())
+	_, found = k.GetConsent(ctx, patient, provider, hash)
+	require.False(t, found)
+
+	// nothing is left in the expiration queue
+	ctx = ctx.WithBlockTime(expiration).WithEventManager(sdk.NewEventManager())
+	k.DeleteExpiredConsents(ctx)
+	require.Zero(t, countEvents(ctx, types.EventTypeConsentExpired))
+}
+
+func TestConsentIndexQueries(t *testing.T) {
+	ctx, k := setupKeeper(t, fakeFileHash{})
+	goCtx := sdk.WrapSDKContext(ctx)
+	patients, providers := consentParties()
+	var (
+		hashA = strings.Repeat("c1", 32)
+		hashB = strings.Repeat("c2", 32)
+	)
+	view := types.ConsentScope_CONSENT_SCOPE_VIEW
+	k.SetConsent(ctx, newConsent(patients[0], providers[0], hashA, nil, view))
+	k.SetConsent(ctx, newConsent(patients[0], providers[1], hashA, nil, view))
+	k.SetConsent(ctx, newConsent(patients[1], providers[0], hashB, nil, view))
+	k.SetConsent(ctx, newConsent(patients[1], providers[0], hashA, nil, view))
+
+	type pair struct{ patient, provider, hash string }
+	pairs := func(consents []*types.Consent) []pair {
+		var out []pair
+		for _, c := range consents {
+			out = append(out, pair{c.Patient, c.Provider, c.FileHash})
+		}
+		return out
+	}
+
+	byProvider, err := k.ConsentsByProvider(goCtx, &types.QueryConsentsByProviderRequest{Provider: providers[0].String()})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []pair{
+		{patients[0].String(), providers[0].String(), hashA},
+		{patients[1].String(), providers[0].String(), hashB},
+		{patients[1].String(), providers[0].String(), hashA},
+	}, pairs(byProvider.Consents))
+
+	byProvider, err = k.ConsentsByProvider(goCtx, &types.QueryConsentsByProviderRequest{Provider: providers[1].String()})
+	require.NoError(t, err)
+	require.Equal(t, []pair{{patients[0].String(), providers[1].String(), hashA}}, pairs(byProvider.Consents))
+
+	byFile, err := k.ConsentsByFile(goCtx, &types.QueryConsentsByFileRequest{FileHash: strings.ToUpper(hashA)})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []pair{
+		{patients[0].String(), providers[0].String(), hashA},
+		{patients[0].String(), providers[1].String(), hashA},
+		{patients[1].String(), providers[0].String(), hashA},
+	}, pairs(byFile.Consents))
+
+	byFile, err = k.ConsentsByFile(goCtx, &types.QueryConsentsByFileRequest{FileHash: hashB})
+	require.NoError(t, err)
+	require.Equal(t, []pair{{patients[1].String(), providers[0].String(), hashB}}, pairs(byFile.Consents))
+
+	// 페이지 단위로 나누어도 모든 동의를 한 번씩 돌려준다
+	var paged []pair
+	pageReq := &query.PageRequest{Limit: 1}
+	for {
+		res, err := k.ConsentsByFile(goCtx, &types.QueryConsentsByFileRequest{FileHash: hashA, Pagination: pageReq})
+		require.NoError(t, err)
+		require.Len(t, res.Consents, 1)
+		paged = append(paged, pairs(res.Consents)...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+	require.Len(t, paged, 3)
+	require.ElementsMatch(t, []pair{
+		{patients[0].String(), providers[0].String(), hashA},
+		{patients[0].String(), providers[1].String(), hashA},
+		{patients[1].String(), providers[0].String(), hashA},
+	}, paged)
+}
+
+func TestConsentGenesisRoundTrip(t *testing.T) {
+	ctx, k := setupKeeper(t, fakeFileHash{})
+	patients, providers := consentParties()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(24 * time.Hour)
+
+	genesis := &types.GenesisState{Consents: []*types.Consent{
+		newConsent(patients[0], providers[0], strings.Repeat("d1", 32), &later,
+			types.ConsentScope_CONSENT_SCOPE_VIEW, types.ConsentScope_CONSENT_SCOPE_SHARE),
+		newConsent(patients[0], providers[1], strings.Repeat("d1", 32), nil, types.ConsentScope_CONSENT_SCOPE_RESEARCH),
+		newConsent(patients[1], providers[0], strings.Repeat("d2", 32), nil, types.ConsentScope_CONSENT_SCOPE_VIEW),
+	}}
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	k.InitGenesis(ctx, genesis)
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(exported))
+	require.ElementsMatch(t, genesis.Consents, exported.Consents)
+
+	// the imported consents are indexed and queued like granted ones
+	byProvider, err := k.ConsentsByProvider(sdk.WrapSDKContext(ctx), &types.QueryConsentsByProviderRequest{Provider: providers[0].String()})
+	require.NoError(t, err)
+	require.Len(t, byProvider.Consents, 2)
+	ctx = ctx.WithBlockTime(later).WithEventManager(sdk.NewEventManager())
+	k.DeleteExpiredConsents(ctx)
+	require.Equal(t, 1, countEvents(ctx, types.EventTypeConsentExpired))
+	require.Len(t, k.ExportGenesis(ctx).Consents, 2)
+
+	// the same consent twice is rejected
+	dup := &types.GenesisState{Consents: []*types.Consent{genesis.Consents[1], genesis.Consents[1]}}
+	require.Error(t, types.ValidateGenesis(dup))
+}
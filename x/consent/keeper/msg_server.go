@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"doctorium/x/consent/types"
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// GrantConsent implements the Msg/GrantConsent method. Only the patient the
+// document is bound to, at registration or with MsgBindPatient, may grant
+// consent on it.
+func (k Keeper) GrantConsent(goCtx context.Context, msg *types.MsgGrantConsent) (*types.MsgGrantConsentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	patient, err := sdk.AccAddressFromBech32(msg.Patient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	provider, err := sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	// filehash가 저장하는 정규형으로 찾는다
+	hash, err := filehashtypes.NormalizeRegisteredHash(msg.FileHash)
+	if err != nil {
+		return nil, err
+	}
+	record, found := k.fileHashKeeper.GetFileRecord(ctx, hash)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrFileNotFound, hash)
+	}
+	// 서명자가 스스로 환자라고 주장하는 것만으로는 부족하다
+	if record.Patient == "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "file %s is not bound to a patient", hash)
+	}
+	if record.Patient != msg.Patient {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "file %s belongs to another patient", hash)
+	}
+	scopes, err := types.NormalizeScopes(msg.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	if len(scopes) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalidScope, "at least one scope must be granted")
+	}
+	if msg.Expiration != nil && !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidExpiration, "%s is not after the current block time", *msg.Expiration)
+	}
+
+	if existing, found := k.GetConsent(ctx, patient, provider, hash); found {
+		k.DeleteConsent(ctx, existing)
+	}
+	consent := &types.Consent{
+		Patient:     msg.Patient,
+		Provider:    msg.Provider,
+		FileHash:    hash,
+		Scopes:      scopes,
+		Expiration:  msg.Expiration,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+	}
+	k.SetConsent(ctx, consent)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyPatient, msg.Patient),
+		sdk.NewAttribute(types.AttributeKeyProvider, msg.Provider),
+		sdk.NewAttribute(types.AttributeKeyFileHash, hash),
+		sdk.NewAttribute(types.AttributeKeyScopes, types.ScopesString(scopes)),
+	}
+	if msg.Expiration != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyExpiration, msg.Expiration.String()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeConsentGranted, attrs...))
+	return &types.MsgGrantConsentResponse{}, nil
+}
+
+// RevokeConsent implements the Msg/RevokeConsent method. Revoking without
+// scopes, or revoking every granted scope, removes the consent.
+func (k Keeper) RevokeConsent(goCtx context.Context, msg *types.MsgRevokeConsent) (*types.MsgRevokeConsentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	patient, err := sdk.AccAddressFromBech32(msg.Patient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	provider, err := sdk.AccAddressFromBech32(msg.Provider)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	hash, err := filehashtypes.NormalizeRegisteredHash(msg.FileHash)
+	if err != nil {
+		return nil, err
+	}
+	consent, found := k.GetConsent(ctx, patient, provider, hash)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrConsentNotFound, "%s has not granted %s consent on %s", msg.Patient, msg.Provider, hash)
+	}
+	revoked, err := types.NormalizeScopes(msg.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	if len(revoked) == 0 {
+		revoked = consent.Scopes
+	}
+
+	k.DeleteConsent(ctx, consent)
+	var remaining []types.ConsentScope
+	for _, s := range consent.Scopes {
+		keep := true
+		for _, r := range revoked {
+			if s == r {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			remaining = append(remaining, s)
+		}
+	}
+	if len(remaining) > 0 {
+		consent.Scopes = remaining
+		k.SetConsent(ctx, consent)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConsentRevoked,
+		sdk.NewAttribute(types.AttributeKeyPatient, msg.Patient),
+		sdk.NewAttribute(types.AttributeKeyProvider, msg.Provider),
+		sdk.NewAttribute(types.AttributeKeyFileHash, hash),
+		sdk.NewAttribute(types.AttributeKeyScopes, types.ScopesString(revoked)),
+	))
+	return &types.MsgRevokeConsentResponse{}, nil
+}
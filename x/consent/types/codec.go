@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// ModuleCdc is the global Legacy Amino codec.
+var ModuleCdc = codec.NewLegacyAmino()
+
+// RegisterLegacyAminoCodec registers concrete types on the Amino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgGrantConsent{}, "doctorium/consent/MsgGrantConsent", nil)
+	cdc.RegisterConcrete(&MsgRevokeConsent{}, "doctorium/consent/MsgRevokeConsent", nil)
+}
+
+// RegisterInterfaces registers module message interfaces with the protobuf
+// InterfaceRegistry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgGrantConsent{},
+		&MsgRevokeConsent{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// Validate performs stateless checks on a stored consent.
+func (c *Consent) Validate() error {
+	if c == nil {
+		return errors.New("consent cannot be nil")
+	}
+	if err := validateParties(c.Patient, c.Provider); err != nil {
+		return err
+	}
+	hash, err := filehashtypes.NormalizeRegisteredHash(c.FileHash)
+	if err != nil {
+		return err
+	}
+	if c.FileHash != hash {
+		return fmt.Errorf("file hash %s is not in canonical lowercase form", c.FileHash)
+	}
+	if len(c.Scopes) == 0 {
+		return sdkerrors.Wrap(ErrInvalidScope, "consent grants no scope")
+	}
+	normalized, err := NormalizeScopes(c.Scopes)
+	if err != nil {
+		return err
+	}
+	if len(normalized) != len(c.Scopes) {
+		return sdkerrors.Wrap(ErrInvalidScope, "duplicate scope")
+	}
+	for i := range normalized {
+		if normalized[i] != c.Scopes[i] {
+			return sdkerrors.Wrap(ErrInvalidScope, "scopes are not sorted")
+		}
+	}
+	if c.BlockHeight < 0 {
+		return fmt.Errorf("negative block height %d", c.BlockHeight)
+	}
+	return nil
+}
+
+// HasScope reports whether the consent grants scope.
+func (c *Consent) HasScope(scope ConsentScope) bool {
+	for _, s := range c.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired reports whether the consent has lapsed at t.
+func (c *Consent) IsExpired(t time.Time) bool {
+	return c.Expiration != nil && !t.Before(*c.Expiration)
+}
+
+// NormalizeScopes sorts scopes and drops duplicates. Unspecified or unknown
+// scopes are rejected.
+func NormalizeScopes(scopes []ConsentScope) ([]ConsentScope, error) {
+	seen := make(map[ConsentScope]struct{}, len(scopes))
+	normalized := make([]ConsentScope, 0, len(scopes))
+	for _, s := range scopes {
+		if _, known := ConsentScope_name[int32(s)]; !known || s == ConsentScope_CONSENT_SCOPE_UNSPECIFIED {
+			return nil, sdkerrors.Wrapf(ErrInvalidScope, "%d", s)
+		}
+		if _, dup := seen[s]; dup {
+			continue
+		}
+		seen[s] = struct{}{}
+		normalized = append(normalized, s)
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i] < normalized[j] })
+	return normalized, nil
+}
+
+// ScopesString joins scopes in their short form, e.g. "view,share".
+func ScopesString(scopes []ConsentScope) string {
+	names := make([]string, len(scopes))
+	for i, s := range scopes {
+		names[i] = strings.ToLower(strings.TrimPrefix(s.String(), "CONSENT_SCOPE_"))
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseConsentScope parses a scope given either as its full enum name or in
+// short form, e.g. "view".
+func ParseConsentScope(s string) (ConsentScope, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+	if !strings.HasPrefix(name, "CONSENT_SCOPE_") {
+		name = "CONSENT_SCOPE_" + name
+	}
+	v, ok := ConsentScope_value[name]
+	if !ok || v == int32(ConsentScope_CONSENT_SCOPE_UNSPECIFIED) {
+		return ConsentScope_CONSENT_SCOPE_UNSPECIFIED, fmt.Errorf("unknown consent scope %q", s)
+	}
+	return ConsentScope(v), nil
+}
+
+// validateParties checks the patient and provider addresses of a consent.
+func validateParties(patient, provider string) error {
+	patientAddr, err := sdk.AccAddressFromBech32(patient)
+	if err != nil {
+		return fmt.Errorf("invalid patient address: %w", err)
+	}
+	providerAddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return fmt.Errorf("invalid provider address: %w", err)
+	}
+	if patientAddr.Equals(providerAddr) {
+		return errors.New("patient cannot grant consent to themselves")
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package types
+
+import "github.com/cosmos/cosmos-sdk/types/errors"
+
+var (
+	ErrFileNotFound      = errors.Register(ModuleName, 1, "file not found")
+	ErrConsentNotFound   = errors.Register(ModuleName, 2, "consent not found")
+	ErrInvalidScope      = errors.Register(ModuleName, 3, "invalid consent scope")
+	ErrInvalidExpiration = errors.Register(ModuleName, 4, "invalid consent expiration")
+)
@@ -0,0 +1,14 @@
+package types
+
+// consent module event types and attribute keys.
+const (
+	EventTypeConsentGranted = "consent_granted"
+	EventTypeConsentRevoked = "consent_revoked"
+	EventTypeConsentExpired = "consent_expired"
+
+	AttributeKeyPatient    = "patient"
+	AttributeKeyProvider   = "provider"
+	AttributeKeyFileHash   = "file_hash"
+	AttributeKeyScopes     = "scopes"
+	AttributeKeyExpiration = "expiration"
+)
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// FileHashKeeper defines the filehash keeper methods used to check that a
+// consent refers to a registered document of the granting patient.
+type FileHashKeeper interface {
+	GetFileRecord(ctx sdk.Context, hash string) (*filehashtypes.FileRecord, bool)
+}
@@ -0,0 +1,27 @@
+package types
+
+import "fmt"
+
+// DefaultGenesis returns the default genesis state of the consent module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Consents: []*Consent{},
+	}
+}
+
+// ValidateGenesis checks that every consent is well formed and that no
+// patient grants the same provider consent on a document twice.
+func ValidateGenesis(data *GenesisState) error {
+	seen := make(map[string]struct{})
+	for i, c := range data.Consents {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("invalid consent at index %d: %w", i, err)
+		}
+		key := c.Patient + "/" + c.Provider + "/" + c.FileHash
+		if _, exists := seen[key]; exists {
+			return fmt.Errorf("duplicate consent in genesis: %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName   = "consent"  // 모듈 이름
+	RouterKey    = ModuleName // Msg 라우팅 시 사용
+	QuerierRoute = ModuleName // Querier 라우팅 시 사용
+	StoreKey     = ModuleName // KVStore key
+)
+
+// Store layout. A consent is stored once under its patient and indexed by
+// provider, by document and, when it expires, by expiration time.
+var (
+	ConsentPrefix         = []byte{0x01} // 0x01 | len(patient) | patient | len(provider) | provider | hash -> Consent
+	ProviderIndexPrefix   = []byte{0x02} // 0x02 | len(provider) | provider | len(patient) | patient | hash -> []byte{}
+	FileIndexPrefix       = []byte{0x03} // 0x03 | len(hash) | hash | len(patient) | patient | len(provider) | provider -> []byte{}
+	ExpirationQueuePrefix = []byte{0x04} // 0x04 | expiration | consent key -> []byte{}
+)
+
+// PatientConsentsPrefix returns the prefix under which all consents granted
+// by patient are stored.
+func PatientConsentsPrefix(patient sdk.AccAddress) []byte {
+	return append(append([]byte{}, ConsentPrefix...), address.MustLengthPrefix(patient)...)
+}
+
+// ConsentKey returns the store key of the consent patient granted provider
+// on hash.
+func ConsentKey(patient, provider sdk.AccAddress, hash string) []byte {
+	key := append(PatientConsentsPrefix(patient), address.MustLengthPrefix(provider)...)
+	return append(key, hash...)
+}
+
+// ProviderIndexPrefixFor returns the prefix under which all consents granted
+// to provider are indexed.
+func ProviderIndexPrefixFor(provider sdk.AccAddress) []byte {
+	return append(append([]byte{}, ProviderIndexPrefix...), address.MustLengthPrefix(provider)...)
+}
+
+// ProviderIndexKey returns the provider index key of a consent.
+func ProviderIndexKey(patient, provider sdk.AccAddress, hash string) []byte {
+	key := append(ProviderIndexPrefixFor(provider), address.MustLengthPrefix(patient)...)
+	return append(key, hash...)
+}
+
+// FileIndexPrefixFor returns the prefix under which all consents on hash are
+// indexed.
+func FileIndexPrefixFor(hash string) []byte {
+	return append(append([]byte{}, FileIndexPrefix...), address.MustLengthPrefix([]byte(hash))...)
+}
+
+// FileIndexKey returns the file index key of a consent.
+func FileIndexKey(patient, provider sdk.AccAddress, hash string) []byte {
+	key := append(FileIndexPrefixFor(hash), address.MustLengthPrefix(patient)...)
+	return append(key, address.MustLengthPrefix(provider)...)
+}
+
+// ExpirationQueueTimePrefix returns the prefix of the consents expiring at t.
+func ExpirationQueueTimePrefix(t time.Time) []byte {
+	return append(append([]byte{}, ExpirationQueuePrefix...), sdk.FormatTimeBytes(t)...)
+}
+
+// ExpirationQueueKey returns the expiration queue key of a consent.
+func ExpirationQueueKey(t time.Time, patient, provider sdk.AccAddress, hash string) []byte {
+	return append(ExpirationQueueTimePrefix(t), ConsentKey(patient, provider, hash)...)
+}
+
+// ParseExpirationQueueKey returns the consent key an expiration queue key
+// points to. Queue times are fixed-width sortable timestamps.
+func ParseExpirationQueueKey(key []byte) []byte {
+	return key[len(ExpirationQueuePrefix)+len(sdk.FormatTimeBytes(time.Time{})):]
+}
+
+// ParseProviderIndexKey splits a provider index key, with the provider
+// prefix already stripped, into the patient and the file hash.
+func ParseProviderIndexKey(key []byte) (sdk.AccAddress, string) {
+	addrLen := int(key[0])
+	return sdk.AccAddress(key[1 : 1+addrLen]), string(key[1+addrLen:])
+}
+
+// ParseFileIndexKey splits a file index key, with the file prefix already
+// stripped, into the patient and the provider.
+func ParseFileIndexKey(key []byte) (sdk.AccAddress, sdk.AccAddress) {
+	patientLen := int(key[0])
+	patient := sdk.AccAddress(key[1 : 1+patientLen])
+	key = key[1+patientLen:]
+	providerLen := int(key[0])
+	return patient, sdk.AccAddress(key[1 : 1+providerLen])
+}
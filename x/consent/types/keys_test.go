@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/consent/types"
+)
+
+func TestConsentKeys(t *testing.T) {
+	patient := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	provider := sdk.AccAddress(bytes.Repeat([]byte{2}, 32))
+	hash := strings.Repeat("ab", 32)
+	expiration := time.Date(2025, 3, 1, 12, 0, 0, 500, time.UTC)
+
+	key := types.ExpirationQueueKey(expiration, patient, provider, hash)
+	require.Equal(t, types.ConsentKey(patient, provider, hash), types.ParseExpirationQueueKey(key))
+
+	// 만료 큐는 시각 순으로 정렬되고, 같은 시각의 항목은 그 시각의 접두사 아래에 있다
+	require.True(t, bytes.HasPrefix(key, types.ExpirationQueueTimePrefix(expiration)))
+	for _, other := range []time.Time{expiration.Add(-time.Nanosecond), expiration.Add(-time.Hour)} {
+		earlier := types.ExpirationQueueKey(other, patient, provider, hash)
+		require.Negative(t, bytes.Compare(earlier, key), other)
+		require.Negative(t, bytes.Compare(earlier, sdk.PrefixEndBytes(types.ExpirationQueueTimePrefix(expiration))))
+	}
+	later := types.ExpirationQueueKey(expiration.Add(time.Nanosecond), patient, provider, hash)
+	require.Positive(t, bytes.Compare(later, sdk.PrefixEndBytes(types.ExpirationQueueTimePrefix(expiration))))
+
+	providerKey := types.ProviderIndexKey(patient, provider, hash)
+	require.True(t, bytes.HasPrefix(providerKey, types.ProviderIndexPrefixFor(provider)))
+	gotPatient, gotHash := types.ParseProviderIndexKey(providerKey[len(types.ProviderIndexPrefixFor(provider)):])
+	require.Equal(t, patient, gotPatient)
+	require.Equal(t, hash, gotHash)
+
+	fileKey := types.FileIndexKey(patient, provider, hash)
+	require.True(t, bytes.HasPrefix(fileKey, types.FileIndexPrefixFor(hash)))
+	gotPatient, gotProvider := types.ParseFileIndexKey(fileKey[len(types.FileIndexPrefixFor(hash)):])
+	require.Equal(t, patient, gotPatient)
+	require.Equal(t, provider, gotProvider)
+}
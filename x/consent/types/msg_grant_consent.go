@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// Ensure MsgGrantConsent implements the sdk.Msg interface
+var _ sdk.Msg = &MsgGrantConsent{}
+
+// Route implements sdk.Msg
+func (msg *MsgGrantConsent) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgGrantConsent) Type() string {
+	return "GrantConsent"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgGrantConsent) ValidateBasic() error {
+	if err := validateParties(msg.Patient, msg.Provider); err != nil {
+		return err
+	}
+	if _, err := filehashtypes.NormalizeRegisteredHash(msg.FileHash); err != nil {
+		return err
+	}
+	if len(msg.Scopes) == 0 {
+		return fmt.Errorf("at least one scope must be granted")
+	}
+	if _, err := NormalizeScopes(msg.Scopes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgGrantConsent) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgGrantConsent) GetSigners() []sdk.AccAddress {
+	patient, err := sdk.AccAddressFromBech32(msg.Patient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{patient}
+}
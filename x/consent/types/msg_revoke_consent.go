@@ -0,0 +1,55 @@
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	filehashtypes "doctorium/x/filehash/types"
+)
+
+// Ensure MsgRevokeConsent implements the sdk.Msg interface
+var _ sdk.Msg = &MsgRevokeConsent{}
+
+// Route implements sdk.Msg
+func (msg *MsgRevokeConsent) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgRevokeConsent) Type() string {
+	return "RevokeConsent"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRevokeConsent) ValidateBasic() error {
+	if err := validateParties(msg.Patient, msg.Provider); err != nil {
+		return err
+	}
+	if _, err := filehashtypes.NormalizeRegisteredHash(msg.FileHash); err != nil {
+		return err
+	}
+	// 범위를 지정하지 않으면 동의 전체를 철회한다
+	if _, err := NormalizeScopes(msg.Scopes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRevokeConsent) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRevokeConsent) GetSigners() []sdk.AccAddress {
+	patient, err := sdk.AccAddressFromBech32(msg.Patient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{patient}
+}
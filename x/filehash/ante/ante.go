@@ -0,0 +1,73 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"doctorium/x/filehash/types"
+)
+
+// FileHashKeeper defines the filehash keeper methods used by the decorator.
+type FileHashKeeper interface {
+	IsBlockedAccount(ctx sdk.Context, addr sdk.AccAddress) bool
+}
+
+// RejectBlockedUploadsDecorator rejects transactions carrying a MsgUploadFile,
+// MsgUploadFiles or MsgAnchorMerkleRoot whose creator is blocked from
+// uploading, including uploads wrapped in an authz MsgExec.
+type RejectBlockedUploadsDecorator struct {
+	fk FileHashKeeper
+}
+
+// NewRejectBlockedUploadsDecorator creates a new RejectBlockedUploadsDecorator.
+func NewRejectBlockedUploadsDecorator(fk FileHashKeeper) RejectBlockedUploadsDecorator {
+	return RejectBlockedUploadsDecorator{fk: fk}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d RejectBlockedUploadsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := d.checkMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d RejectBlockedUploadsDecorator) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *types.MsgUploadFile:
+			if err := d.checkCreator(ctx, msg.Creator); err != nil {
+				return err
+			}
+		case *types.MsgUploadFiles:
+			if err := d.checkCreator(ctx, msg.Creator); err != nil {
+				return err
+			}
+		case *types.MsgAnchorMerkleRoot:
+			if err := d.checkCreator(ctx, msg.Creator); err != nil {
+				return err
+			}
+		case *authz.MsgExec:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.checkMsgs(ctx, inner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d RejectBlockedUploadsDecorator) checkCreator(ctx sdk.Context, creator string) error {
+	addr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if d.fk.IsBlockedAccount(ctx, addr) {
+		return sdkerrors.Wrapf(types.ErrBlockedAccount, "%s", creator)
+	}
+	return nil
+}
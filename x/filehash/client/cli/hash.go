@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+
+	"doctorium/x/filehash/types"
+)
+
+// multihash code of sha2-256, used when hashing files with the multihash algorithm
+const multihashSHA256Code = 0x12
+
+// HashFile hashes the file at path with the given algorithm and returns the
+// hex encoded digest in the form expected by MsgUploadFile. Files hashed with
+// the multihash algorithm are digested with sha2-256.
+func HashFile(path, algorithm string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	return HashReader(f, algorithm)
+}
+
+// HashReader hashes everything read from r with the given algorithm.
+func HashReader(r io.Reader, algorithm string) (string, error) {
+	var h hash.Hash
+	switch algorithm {
+	case types.HashAlgorithmSHA256, types.HashAlgorithmMultihash:
+		h = sha256.New()
+	case types.HashAlgorithmSHA3_256:
+		h = sha3.New256()
+	case types.HashAlgorithmBlake2b256:
+		h, _ = blake2b.New256(nil)
+	default:
+		return "", fmt.Errorf("%w: %q", types.ErrUnsupportedHashAlgorithm, algorithm)
+	}
+
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	digest := h.Sum(nil)
+
+	if algorithm == types.HashAlgorithmMultihash {
+		digest = append([]byte{multihashSHA256Code, byte(len(digest))}, digest...)
+	}
+	return hex.EncodeToString(digest), nil
+}
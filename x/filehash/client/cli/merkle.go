@@ -0,0 +1,202 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"doctorium/x/filehash/merkle"
+	"doctorium/x/filehash/types"
+)
+
+const (
+	FlagTreeAlgorithm = "tree-algo"
+	FlagFile          = "file"
+)
+
+// GetMerkleCmd returns the offline merkle tools working on batches anchored
+// with MsgAnchorMerkleRoot.
+func GetMerkleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Merkle inclusion proofs for anchored document batches",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdProve(),
+		CmdVerifyInclusion(),
+	)
+	return cmd
+}
+
+// CmdProve builds the merkle tree of a batch of local files and prints the
+// inclusion proof of one of them.
+func CmdProve() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove [file] [file-or-dir...]",
+		Short: "Print the inclusion proof of a file in a batch",
+		Long: `Hash the batch given by the remaining arguments exactly like anchor-root does,
+build its merkle tree and print the inclusion proof of [file] as JSON. The
+batch must be given in the same order and with the same --hash-algo and
+--tree-algo as when it was anchored; directories are walked in lexical order.`,
+		Example: `$ doctoriumd filehash prove ./exports/2024-05-01/0042.dcm ./exports/2024-05-01 > 0042.proof.json`,
+		Args:    cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hashAlgorithm, _ := cmd.Flags().GetString(FlagHashAlgorithm)
+			treeAlgorithm, _ := cmd.Flags().GetString(FlagTreeAlgorithm)
+
+			leaf, err := hashLeaf(args[0], hashAlgorithm)
+			if err != nil {
+				return err
+			}
+			tree, leaves, err := BuildFileTree(args[1:], hashAlgorithm, treeAlgorithm)
+			if err != nil {
+				return err
+			}
+
+			index := -1
+			for i := range leaves {
+				if bytes.Equal(leaves[i], leaf) {
+					index = i
+					break
+				}
+			}
+			if index < 0 {
+				return fmt.Errorf("%s is not part of the batch", args[0])
+			}
+
+			proof, err := tree.Prove(uint64(index), leaf)
+			if err != nil {
+				return err
+			}
+			bz, err := json.MarshalIndent(proof, "", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cmd.Flags().String(FlagHashAlgorithm, types.DefaultHashAlgorithm, "hash algorithm of the leaves: sha256, sha3-256, blake2b-256 or multihash")
+	cmd.Flags().String(FlagTreeAlgorithm, merkle.DefaultAlgorithm, "tree algorithm: rfc6962-sha256, rfc6962-sha3-256 or rfc6962-blake2b-256")
+	return cmd
+}
+
+// CmdVerifyInclusion checks an inclusion proof against the root anchored on
+// chain.
+func CmdVerifyInclusion() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-inclusion [proof-file]",
+		Short: "Verify an inclusion proof against an anchored root",
+		Long: `Recompute the root from a proof written by prove and check that it has been
+anchored on chain with the same leaf count and tree algorithm. With --file the
+leaf of the proof must additionally be the digest of the given local file.`,
+		Example: `$ doctoriumd filehash verify-inclusion 0042.proof.json --file ./0042.dcm`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var proof merkle.Proof
+			if err := json.Unmarshal(bz, &proof); err != nil {
+				return fmt.Errorf("parse proof: %w", err)
+			}
+
+			if path, _ := cmd.Flags().GetString(FlagFile); path != "" {
+				algorithm, _ := cmd.Flags().GetString(FlagHashAlgorithm)
+				leaf, err := hashLeaf(path, algorithm)
+				if err != nil {
+					return err
+				}
+				if !strings.EqualFold(hex.EncodeToString(leaf), proof.Leaf) {
+					return fmt.Errorf("%s does not match the leaf of the proof", path)
+				}
+			}
+
+			root, err := proof.ComputeRoot()
+			if err != nil {
+				return fmt.Errorf("invalid proof: %w", err)
+			}
+			rootHex := hex.EncodeToString(root)
+			if proof.Root != "" && !strings.EqualFold(proof.Root, rootHex) {
+				return fmt.Errorf("proof leads to root %s, but names root %s", rootHex, proof.Root)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AnchoredRoot(cmd.Context(), &types.QueryAnchoredRootRequest{Root: rootHex})
+			if err != nil {
+				return fmt.Errorf("root %s: %w", rootHex, err)
+			}
+			anchored := res.AnchoredRoot
+			if anchored.TreeAlgorithm != proof.Algorithm {
+				return fmt.Errorf("root %s was anchored with tree algorithm %s, proof uses %s", rootHex, anchored.TreeAlgorithm, proof.Algorithm)
+			}
+			if anchored.LeafCount != proof.LeafCount {
+				return fmt.Errorf("root %s was anchored with %d leaves, proof claims %d", rootHex, anchored.LeafCount, proof.LeafCount)
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "local file whose digest must be the leaf of the proof")
+	cmd.Flags().String(FlagHashAlgorithm, types.DefaultHashAlgorithm, "hash algorithm used for --file: sha256, sha3-256, blake2b-256 or multihash")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// BuildFileTree hashes every file given by paths with hashAlgorithm and builds
+// the merkle tree of their digests. Directories are walked recursively in
+// lexical order. The digests are returned in leaf order.
+func BuildFileTree(paths []string, hashAlgorithm, treeAlgorithm string) (*merkle.Tree, [][]byte, error) {
+	var leaves [][]byte
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			leaf, err := hashLeaf(p, hashAlgorithm)
+			if err != nil {
+				return err
+			}
+			leaves = append(leaves, leaf)
+			return nil
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	tree, err := merkle.New(treeAlgorithm, leaves)
+	if err != nil {
+		return nil, nil, err
+	}
+	return tree, leaves, nil
+}
+
+// hashLeaf returns the raw digest of the file at path.
+func hashLeaf(path, algorithm string) ([]byte, error) {
+	digest, err := HashFile(path, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	return hex.DecodeString(digest)
+}
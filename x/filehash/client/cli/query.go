@@ -0,0 +1,427 @@
+package cli
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/light"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"doctorium/x/filehash/client/utils"
+	"doctorium/x/filehash/types"
+)
+
+const (
+	FlagProve       = "prove"
+	FlagTrustHeight = "trust-height"
+	FlagTrustHash   = "trust-hash"
+	FlagTrustPeriod = "trust-period"
+	FlagWitnesses   = "witnesses"
+	FlagWaitTimeout = "wait-timeout"
+)
+
+// GetQueryCmd returns the cli query commands for the filehash module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the filehash module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdListFiles(),
+		CmdShowFile(),
+		CmdFilesByCreator(),
+		CmdFilesByOwner(),
+		CmdOwnershipHistory(),
+		CmdAnchoredRoot(),
+		CmdAnchoredRoots(),
+		CmdLegacyEntries(),
+		CmdVerifyFile(),
+		CmdQueryParams(),
+	)
+	return cmd
+}
+
+// CmdListFiles lists all registered files.
+func CmdListFiles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List all registered files",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FileList(cmd.Context(), &types.QueryFileListRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "list")
+	return cmd
+}
+
+// CmdShowFile shows the record registered for a hash.
+func CmdShowFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [hash]",
+		Short: "Show the record registered for a file hash",
+		Long: `Show the record registered for a file hash. With --prove the record is read
+from the store together with a merkle proof against the app hash.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if prove, _ := cmd.Flags().GetBool(FlagProve); prove {
+				res, err := utils.QueryFileABCI(clientCtx, args[0], true)
+				if err != nil {
+					return err
+				}
+				return printProvenFile(clientCtx, res)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.File(cmd.Context(), &types.QueryFileRequest{FileHash: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagProve, false, "fetch the record with a merkle proof")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdFilesByCreator lists the files registered by a creator address.
+func CmdFilesByCreator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-creator [address]",
+		Short: "List the files registered by a creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FilesByCreator(cmd.Context(), &types.QueryFilesByCreatorRequest{
+				Creator:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "by-creator")
+	return cmd
+}
+
+// CmdFilesByOwner lists the files currently owned by an address.
+func CmdFilesByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "by-owner [address]",
+		Short: "List the files currently owned by an address",
+		Long: `List the files currently owned by an address: those it registered and still
+holds, and those transferred to it. by-creator lists the files an address
+registered, whoever owns them now.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FilesByOwner(cmd.Context(), &types.QueryFilesByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "by-owner")
+	return cmd
+}
+
+// CmdOwnershipHistory lists the ownership transfers of a document.
+func CmdOwnershipHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ownership-history [hash]",
+		Short: "List the ownership transfers of a registered document",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OwnershipHistory(cmd.Context(), &types.QueryOwnershipHistoryRequest{FileHash: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdAnchoredRoot shows an anchored merkle root.
+func CmdAnchoredRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchored-root [root]",
+		Short: "Show an anchored merkle root",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AnchoredRoot(cmd.Context(), &types.QueryAnchoredRootRequest{Root: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdAnchoredRoots lists all anchored merkle roots.
+func CmdAnchoredRoots() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchored-roots",
+		Short: "List all anchored merkle roots",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AnchoredRoots(cmd.Context(), &types.QueryAnchoredRootsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "anchored-roots")
+	return cmd
+}
+
+// CmdLegacyEntries lists the v1 entries the v2 migration kept aside.
+func CmdLegacyEntries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "legacy-entries",
+		Short: "List the v1 entries kept aside by the v2 store migration",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.LegacyEntries(cmd.Context(), &types.QueryLegacyEntriesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "legacy-entries")
+	return cmd
+}
+
+// CmdVerifyFile hashes a local file and checks that it is registered.
+func CmdVerifyFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [file]",
+		Short: "Check that a local file is registered",
+		Long: `Hash a local file with --hash-algo and look the digest up on chain. With
+--prove the returned record is additionally checked against the app hash of
+the block header following the queried height. That header is verified with
+the light client starting from a header you already trust, given by
+--trust-height and --trust-hash (take them from a validator operator or
+another source you trust, not from the node you query). Headers served by the
+node are cross-checked against --witnesses.
+
+Without --height the record is proven one block below the latest height; with
+an explicit --height the command waits up to --wait-timeout for the next block.
+Check the status of the record: a registered document may since have been
+revoked or superseded.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			algorithm, _ := cmd.Flags().GetString(FlagHashAlgorithm)
+			hash, err := HashFile(args[0], algorithm)
+			if err != nil {
+				return err
+			}
+
+			if prove, _ := cmd.Flags().GetBool(FlagProve); prove {
+				res, err := utils.QueryFileABCI(clientCtx, hash, true)
+				if err != nil {
+					return fmt.Errorf("%s (%s): %w", args[0], hash, err)
+				}
+				if err := verifyFileProof(cmd, clientCtx, res); err != nil {
+					return err
+				}
+				return printProvenFile(clientCtx, res)
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.File(cmd.Context(), &types.QueryFileRequest{FileHash: hash})
+			if err != nil {
+				return fmt.Errorf("%s (%s): %w", args[0], hash, err)
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagHashAlgorithm, types.DefaultHashAlgorithm, "hash algorithm: sha256, sha3-256, blake2b-256 or multihash")
+	cmd.Flags().Bool(FlagProve, false, "verify the merkle proof of the record against the app hash")
+	cmd.Flags().Int64(FlagTrustHeight, 0, "height of the trusted header the light client starts from (required with --prove)")
+	cmd.Flags().String(FlagTrustHash, "", "hex hash of the trusted header (required with --prove)")
+	cmd.Flags().Duration(FlagTrustPeriod, 168*time.Hour, "trusting period, must be shorter than the unbonding period")
+	cmd.Flags().StringSlice(FlagWitnesses, nil, "RPC addresses of witness nodes to cross-check headers against (default: the queried node)")
+	cmd.Flags().Duration(FlagWaitTimeout, 30*time.Second, "how long to wait for the block committing the proof")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdQueryParams shows the current module parameters.
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Show the filehash module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// printProvenFile prints a record read with its proof. The record is encoded
+// with the proto JSON codec like every other query output.
+func printProvenFile(clientCtx client.Context, res *utils.ProvenFile) error {
+	file, err := clientCtx.Codec.MarshalJSON(res.File)
+	if err != nil {
+		return err
+	}
+	out, err := json.Marshal(struct {
+		File        json.RawMessage    `json:"file"`
+		Proof       *tmcrypto.ProofOps `json:"proof,omitempty"`
+		ProofHeight int64              `json:"proof_height,string"`
+	}{file, res.Proof, res.ProofHeight})
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintRaw(out)
+}
+
+// verifyFileProof checks res against the app hash committed in the header
+// after the proof height. The header is verified with the light client from the
+// trusted header given on the command line.
+func verifyFileProof(cmd *cobra.Command, clientCtx client.Context, res *utils.ProvenFile) error {
+	trustHeight, _ := cmd.Flags().GetInt64(FlagTrustHeight)
+	trustHashHex, _ := cmd.Flags().GetString(FlagTrustHash)
+	if trustHeight == 0 || trustHashHex == "" {
+		return fmt.Errorf("--prove requires --%s and --%s", FlagTrustHeight, FlagTrustHash)
+	}
+	trustHash, err := hex.DecodeString(trustHashHex)
+	if err != nil {
+		return fmt.Errorf("--%s is not hex encoded: %w", FlagTrustHash, err)
+	}
+	trustPeriod, _ := cmd.Flags().GetDuration(FlagTrustPeriod)
+	witnesses, _ := cmd.Flags().GetStringSlice(FlagWitnesses)
+	timeout, _ := cmd.Flags().GetDuration(FlagWaitTimeout)
+
+	ctx, cancel := context.WithTimeout(cmd.Context(), timeout)
+	defer cancel()
+	trust := light.TrustOptions{Period: trustPeriod, Height: trustHeight, Hash: trustHash}
+	appHash, err := utils.TrustedAppHash(ctx, clientCtx, trust, witnesses, res.ProofHeight+1)
+	if err != nil {
+		return err
+	}
+	if err := utils.VerifyFileProof(clientCtx.Codec, appHash, res); err != nil {
+		return fmt.Errorf("proof verification failed: %w", err)
+	}
+	return nil
+}
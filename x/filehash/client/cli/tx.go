@@ -0,0 +1,543 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/spf13/cobra"
+
+	"doctorium/x/filehash/merkle"
+	"doctorium/x/filehash/types"
+)
+
+const (
+	FlagHashAlgorithm = "hash-algo"
+	FlagMimeType      = "mime-type"
+	FlagLabel         = "label"
+	FlagSize          = "size"
+	FlagMaxUploads    = "max-uploads"
+	FlagExpiration    = "expiration"
+	FlagPacketTimeout = "packet-timeout"
+	FlagNote          = "note"
+	FlagAtomic        = "atomic"
+	FlagGrantMsg      = "msg"
+	FlagPatient       = "patient"
+
+	// DefaultPacketTimeout is how long IBC packets stay valid by default
+	DefaultPacketTimeout = 10 * time.Minute
+)
+
+// GetTxCmd returns the transaction commands for the filehash module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Filehash transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdUploadFile(),
+		CmdUploadFiles(),
+		CmdAnchorMerkleRoot(),
+		CmdRevokeFile(),
+		CmdSupersedeFile(),
+		CmdTransferFile(),
+		CmdTransferFiles(),
+		CmdBindPatient(),
+		CmdGrantUpload(),
+		CmdRequestAttestation(),
+		CmdRelayFileRegistered(),
+	)
+	return cmd
+}
+
+// CmdUploadFile registers a document hash, hashing a local file first when a
+// path is given.
+func CmdUploadFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload [hash-or-file]",
+		Short: "Register a document hash",
+		Long: `Register a document hash. The argument is either a hex encoded digest or the
+path of a local file, which is then hashed locally with --hash-algo. When a
+file is given its size and, unless --mime-type is set, its MIME type are filled
+in automatically. --patient binds the document to the patient it is about;
+only that account can later grant consent on it.`,
+		Example: `$ doctoriumd tx filehash upload ./report.pdf --label "blood test" --from clinic
+$ doctoriumd tx filehash upload 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 --size 4 --from clinic`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			algorithm, _ := cmd.Flags().GetString(FlagHashAlgorithm)
+			mimeType, _ := cmd.Flags().GetString(FlagMimeType)
+			label, _ := cmd.Flags().GetString(FlagLabel)
+			size, _ := cmd.Flags().GetUint64(FlagSize)
+			patient, _ := cmd.Flags().GetString(FlagPatient)
+
+			hash := args[0]
+			if info, err := os.Stat(args[0]); err == nil && !info.IsDir() {
+				if hash, err = HashFile(args[0], algorithm); err != nil {
+					return err
+				}
+				size = uint64(info.Size())
+				if mimeType == "" {
+					mimeType = mime.TypeByExtension(filepath.Ext(args[0]))
+				}
+			}
+
+			msg := &types.MsgUploadFile{
+				Creator:       clientCtx.GetFromAddress().String(),
+				FileHash:      hash,
+				Size_:         size,
+				MimeType:      mimeType,
+				Label:         label,
+				HashAlgorithm: algorithm,
+				Patient:       patient,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagHashAlgorithm, types.DefaultHashAlgorithm, "hash algorithm: sha256, sha3-256, blake2b-256 or multihash")
+	cmd.Flags().String(FlagMimeType, "", "MIME type of the document")
+	cmd.Flags().String(FlagLabel, "", "optional human readable label")
+	cmd.Flags().Uint64(FlagSize, 0, "document size in bytes, ignored when a file path is given")
+	cmd.Flags().String(FlagPatient, "", "address of the patient the document is about")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdUploadFiles registers several document hashes in one message, hashing
+// local files first like CmdUploadFile.
+func CmdUploadFiles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-batch [hash-or-file] [hash-or-file...]",
+		Short: "Register several document hashes at once",
+		Long: fmt.Sprintf(`Register up to %d document hashes in one message. Each argument is either a hex
+encoded digest or the path of a local file, which is hashed locally with
+--hash-algo. By default every document is registered on its own and duplicates
+or other failures are reported per item in the response; with --atomic the
+whole batch fails if any document cannot be registered.`, types.MaxUploadBatchSize),
+		Example: `$ doctoriumd tx filehash upload-batch ./exports/*.dcm --from pacs
+$ doctoriumd tx filehash upload-batch ./a.pdf ./b.pdf --atomic --from clinic`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			algorithm, _ := cmd.Flags().GetString(FlagHashAlgorithm)
+			mimeType, _ := cmd.Flags().GetString(FlagMimeType)
+			label, _ := cmd.Flags().GetString(FlagLabel)
+			atomic, _ := cmd.Flags().GetBool(FlagAtomic)
+			patient, _ := cmd.Flags().GetString(FlagPatient)
+
+			files := make([]*types.UploadFileItem, 0, len(args))
+			for _, arg := range args {
+				item := &types.UploadFileItem{
+					FileHash:      arg,
+					MimeType:      mimeType,
+					Label:         label,
+					HashAlgorithm: algorithm,
+					Patient:       patient,
+				}
+				if info, err := os.Stat(arg); err == nil && !info.IsDir() {
+					if item.FileHash, err = HashFile(arg, algorithm); err != nil {
+						return err
+					}
+					item.Size_ = uint64(info.Size())
+					if item.MimeType == "" {
+						item.MimeType = mime.TypeByExtension(filepath.Ext(arg))
+					}
+				}
+				files = append(files, item)
+			}
+
+			msg := &types.MsgUploadFiles{
+				Creator: clientCtx.GetFromAddress().String(),
+				Files:   files,
+				Atomic:  atomic,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagHashAlgorithm, types.DefaultHashAlgorithm, "hash algorithm: sha256, sha3-256, blake2b-256 or multihash")
+	cmd.Flags().String(FlagMimeType, "", "MIME type applied to every document, detected per file when empty")
+	cmd.Flags().String(FlagLabel, "", "optional human readable label applied to every document")
+	cmd.Flags().Bool(FlagAtomic, false, "fail the whole batch if any document cannot be registered")
+	cmd.Flags().String(FlagPatient, "", "address of the patient every document is about")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdAnchorMerkleRoot builds the merkle tree of a batch of local files and
+// anchors its root.
+func CmdAnchorMerkleRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchor-root [file-or-dir] [file-or-dir...]",
+		Short: "Anchor the merkle root of a batch of documents",
+		Long: `Hash every given file with --hash-algo, build the merkle tree of the digests
+with --tree-algo and anchor its root and leaf count. Directories are walked in
+lexical order. Keep the batch unchanged: inclusion proofs are generated from
+the same arguments with 'doctoriumd filehash prove'.`,
+		Example: `$ doctoriumd tx filehash anchor-root ./exports/2024-05-01 --label "lab results 2024-05-01" --from pacs`,
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hashAlgorithm, _ := cmd.Flags().GetString(FlagHashAlgorithm)
+			treeAlgorithm, _ := cmd.Flags().GetString(FlagTreeAlgorithm)
+			label, _ := cmd.Flags().GetString(FlagLabel)
+
+			tree, _, err := BuildFileTree(args, hashAlgorithm, treeAlgorithm)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAnchorMerkleRoot{
+				Creator:       clientCtx.GetFromAddress().String(),
+				Root:          hex.EncodeToString(tree.Root()),
+				LeafCount:     tree.LeafCount(),
+				TreeAlgorithm: treeAlgorithm,
+				Label:         label,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagHashAlgorithm, types.DefaultHashAlgorithm, "hash algorithm of the leaves: sha256, sha3-256, blake2b-256 or multihash")
+	cmd.Flags().String(FlagTreeAlgorithm, merkle.DefaultAlgorithm, "tree algorithm: rfc6962-sha256, rfc6962-sha3-256 or rfc6962-blake2b-256")
+	cmd.Flags().String(FlagLabel, "", "optional human readable label")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRevokeFile revokes a document registered by the signer.
+func CmdRevokeFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [hash] [reason]",
+		Short: "Revoke a registered document",
+		Long: `Mark a document registered by the signer as revoked. The reason is one of
+issued-in-error, wrong-patient, duplicate or other. Revocation is final.`,
+		Example: `$ doctoriumd tx filehash revoke 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 issued-in-error --note "wrong lab values" --from clinic`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reason, err := types.ParseRevocationReason(args[1])
+			if err != nil {
+				return err
+			}
+			note, _ := cmd.Flags().GetString(FlagNote)
+
+			msg := &types.MsgRevokeFile{
+				Creator:  clientCtx.GetFromAddress().String(),
+				FileHash: args[0],
+				Reason:   reason,
+				Note:     note,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagNote, "", "optional note explaining the revocation")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdSupersedeFile links a registered document to its registered replacement.
+func CmdSupersedeFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supersede [old-hash] [new-hash]",
+		Short: "Mark a registered document as replaced by another registered document",
+		Long: `Link a document to the document replacing it. Both must be registered by the
+signer and active; register the corrected document with "upload" first.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSupersedeFile{
+				Creator:     clientCtx.GetFromAddress().String(),
+				OldFileHash: args[0],
+				NewFileHash: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdTransferFile hands a document owned by the signer over to a new owner.
+func CmdTransferFile() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer [hash] [new-owner]",
+		Short:   "Transfer ownership of a registered document",
+		Example: `$ doctoriumd tx filehash transfer 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 doctorium1... --from clinic`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferFile{
+				Creator:  clientCtx.GetFromAddress().String(),
+				FileHash: args[0],
+				NewOwner: args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdBindPatient binds a document registered without a patient to the
+// patient it is about.
+func CmdBindPatient() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind-patient [hash] [patient]",
+		Short: "Bind a registered document to its patient",
+		Long: `Bind a document registered without a patient, such as a document migrated
+from v1, to the patient it is about. Only that patient can then grant consent
+on it. The creator or the current owner of the document may bind it, once.`,
+		Example: `$ doctoriumd tx filehash bind-patient 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 doctorium1... --from clinic`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBindPatient{
+				Creator:  clientCtx.GetFromAddress().String(),
+				FileHash: args[0],
+				Patient:  args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdTransferFiles hands several documents owned by the signer over to the
+// same new owner in one transaction.
+func CmdTransferFiles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-batch [new-owner] [hash] [hash...]",
+		Short: "Transfer ownership of several registered documents at once",
+		Long: fmt.Sprintf(`Transfer up to %d documents to the same new owner. Either every document is
+transferred or, if any transfer fails, none is.`, types.MaxTransferBatchSize),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferFiles{
+				Creator:    clientCtx.GetFromAddress().String(),
+				FileHashes: args[1:],
+				NewOwner:   args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGrantUpload grants an UploadFileAuthorization so the grantee can register
+// documents on behalf of the signer.
+func CmdGrantUpload() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-upload [grantee]",
+		Short: "Allow another account to register documents on your behalf",
+		Long: `Grant an authz UploadFileAuthorization to the grantee, limited to --max-uploads
+uploads and optionally expiring at --expiration (unix timestamp). A grant
+covers one command, chosen with --msg: upload, upload-batch (one upload per
+file of the batch) or anchor-root (one upload per leaf of the anchored tree).
+The grantee submits uploads through authz, e.g. by generating the upload with
+the granter as --from and running "tx authz exec" with its own key. Fees can be
+sponsored with "tx feegrant grant".`,
+		Example: `$ doctoriumd tx filehash grant-upload doctorium1... --max-uploads 100 --expiration 1767225600 --from hospital
+$ doctoriumd tx filehash upload ./report.pdf --from <hospital-address> --generate-only > upload.json
+$ doctoriumd tx authz exec upload.json --from staff --fee-granter <hospital-address>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			maxUploads, _ := cmd.Flags().GetUint64(FlagMaxUploads)
+			grantMsg, _ := cmd.Flags().GetString(FlagGrantMsg)
+			var msgTypeURL string
+			switch grantMsg {
+			case "upload":
+				msgTypeURL = sdk.MsgTypeURL(&types.MsgUploadFile{})
+			case "upload-batch":
+				msgTypeURL = sdk.MsgTypeURL(&types.MsgUploadFiles{})
+			case "anchor-root":
+				msgTypeURL = sdk.MsgTypeURL(&types.MsgAnchorMerkleRoot{})
+			default:
+				return fmt.Errorf("unknown --%s %q, expected upload, upload-batch or anchor-root", FlagGrantMsg, grantMsg)
+			}
+			authorization := types.NewUploadFileAuthorizationFor(msgTypeURL, maxUploads)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if exp, _ := cmd.Flags().GetInt64(FlagExpiration); exp != 0 {
+				e := time.Unix(exp, 0)
+				if !e.After(time.Now()) {
+					return fmt.Errorf("expiration %s is in the past", e)
+				}
+				expiration = &e
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxUploads, 0, "number of uploads the grantee may perform")
+	cmd.Flags().Int64(FlagExpiration, 0, "unix timestamp after which the grant expires")
+	cmd.Flags().String(FlagGrantMsg, "upload", "command the grant covers: upload, upload-batch or anchor-root")
+	_ = cmd.MarkFlagRequired(FlagMaxUploads)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRequestAttestation asks the chain on the other end of a filehash channel
+// whether a document hash is registered there.
+func CmdRequestAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-attestation [src-channel] [hash]",
+		Short: "Ask a counterparty chain over IBC whether a document hash is registered",
+		Long: `Send an attestation request over a filehash IBC channel. The answer is
+returned in the packet acknowledgement and reported in a file_attestation
+event once the relayer delivers it back.`,
+		Example: `$ doctoriumd tx filehash request-attestation channel-0 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 --from clinic`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeout, _ := cmd.Flags().GetDuration(FlagPacketTimeout)
+			msg := &types.MsgRequestAttestation{
+				Sender:           clientCtx.GetFromAddress().String(),
+				FileHash:         args[1],
+				SourceChannel:    args[0],
+				TimeoutTimestamp: uint64(time.Now().Add(timeout).UnixNano()),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagPacketTimeout, DefaultPacketTimeout, "time after which the packet times out")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdRelayFileRegistered sends the record of a registered document to the
+// chain on the other end of a filehash channel.
+func CmdRelayFileRegistered() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "relay-file [src-channel] [hash]",
+		Short:   "Send the record of a registered document to a counterparty chain over IBC",
+		Example: `$ doctoriumd tx filehash relay-file channel-0 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 --from clinic`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeout, _ := cmd.Flags().GetDuration(FlagPacketTimeout)
+			msg := &types.MsgRelayFileRegistered{
+				Sender:           clientCtx.GetFromAddress().String(),
+				FileHash:         args[1],
+				SourceChannel:    args[0],
+				TimeoutTimestamp: uint64(time.Now().Add(timeout).UnixNano()),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagPacketTimeout, DefaultPacketTimeout, "time after which the packet times out")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
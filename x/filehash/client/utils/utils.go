@@ -0,0 +1,193 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	"github.com/cometbft/cometbft/light/provider"
+	httpprovider "github.com/cometbft/cometbft/light/provider/http"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+	tmcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"doctorium/x/filehash/types"
+)
+
+// heightPollInterval is how often the node is polled while waiting for the
+// header that commits a proof.
+const heightPollInterval = time.Second
+
+// ProvenFile is a record read straight from the filehash store, together with
+// the merkle proof of the stored value when one was requested. The Query/File
+// gRPC method cannot serve proofs, so they only travel in this client-side
+// type.
+type ProvenFile struct {
+	File *types.FileRecord `json:"file"`
+	// ICS23 proof of the stored record, nil unless requested
+	Proof *tmcrypto.ProofOps `json:"proof,omitempty"`
+	// height the record was read at; the proof verifies against the app hash
+	// in the header of block ProofHeight+1
+	ProofHeight int64 `json:"proof_height,string"`
+}
+
+// QueryFileABCI reads the record registered for hash straight from the
+// filehash store. When prove is set the response also carries an ICS23 merkle
+// proof of the stored record, which can be checked with VerifyFileProof.
+//
+// Without an explicit height a proven query is made one block below the
+// latest height: the app hash of a height is only committed in the header of
+// the next block, so the latest state cannot be proven yet.
+func QueryFileABCI(clientCtx client.Context, hash string, prove bool) (*ProvenFile, error) {
+	// records are keyed by the lowercase hex digest
+	hash = strings.ToLower(hash)
+
+	height := clientCtx.Height
+	if prove && height == 0 {
+		latest, err := latestHeight(context.Background(), clientCtx)
+		if err != nil {
+			return nil, err
+		}
+		if latest < 2 {
+			return nil, fmt.Errorf("chain at height %d has no provable state yet", latest)
+		}
+		height = latest - 1
+	}
+
+	res, err := clientCtx.QueryABCI(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", types.StoreKey),
+		Height: height,
+		Data:   types.FileKey(hash),
+		Prove:  prove,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Value) == 0 {
+		return nil, status.Errorf(codes.NotFound, "file %s not found", hash)
+	}
+
+	var record types.FileRecord
+	if err := clientCtx.Codec.Unmarshal(res.Value, &record); err != nil {
+		return nil, err
+	}
+	resp := &ProvenFile{File: &record, ProofHeight: res.Height}
+	if prove {
+		resp.Proof = res.ProofOps
+	}
+	return resp, nil
+}
+
+// VerifyFileProof checks the proof carried by resp against appHash, which must
+// be the app hash of the header at resp.ProofHeight+1.
+func VerifyFileProof(cdc codec.BinaryCodec, appHash []byte, resp *ProvenFile) error {
+	if resp.File == nil || resp.Proof == nil || len(resp.Proof.Ops) == 0 {
+		return fmt.Errorf("response carries no file proof")
+	}
+	value, err := cdc.Marshal(resp.File)
+	if err != nil {
+		return err
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(types.StoreKey), merkle.KeyEncodingURL).
+		AppendKey(types.FileKey(resp.File.FileHash), merkle.KeyEncodingURL)
+	return rootmulti.DefaultProofRuntime().VerifyValue(resp.Proof, appHash, keyPath.String(), value)
+}
+
+// TrustedAppHash returns the app hash committed in the header at height. The
+// header is not taken on the node's word: it is verified with the light client,
+// starting from the header named in trust, which the caller must have obtained
+// from a source it trusts. The node of clientCtx serves as primary and the
+// headers it returns are cross-checked against witnesses, which default to the
+// primary itself. If the node has not produced height yet, TrustedAppHash waits
+// for it until ctx is done.
+func TrustedAppHash(ctx context.Context, clientCtx client.Context, trust light.TrustOptions, witnesses []string, height int64) ([]byte, error) {
+	if err := trust.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid trusted header: %w", err)
+	}
+	if height < trust.Height {
+		return nil, fmt.Errorf("height %d is below the trusted height %d", height, trust.Height)
+	}
+
+	chainID := clientCtx.ChainID
+	if chainID == "" {
+		node, err := clientCtx.GetNode()
+		if err != nil {
+			return nil, err
+		}
+		st, err := node.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		chainID = st.NodeInfo.Network
+	}
+	if err := waitForHeight(ctx, clientCtx, height); err != nil {
+		return nil, err
+	}
+
+	primary, err := httpprovider.New(chainID, clientCtx.NodeURI)
+	if err != nil {
+		return nil, fmt.Errorf("connect to %s: %w", clientCtx.NodeURI, err)
+	}
+	witnessProviders := []provider.Provider{primary}
+	if len(witnesses) > 0 {
+		witnessProviders = make([]provider.Provider, len(witnesses))
+		for i, addr := range witnesses {
+			if witnessProviders[i], err = httpprovider.New(chainID, addr); err != nil {
+				return nil, fmt.Errorf("connect to witness %s: %w", addr, err)
+			}
+		}
+	}
+
+	lc, err := light.NewClient(ctx, chainID, trust, primary, witnessProviders,
+		lightdb.New(dbm.NewMemDB(), chainID), light.Logger(log.NewNopLogger()))
+	if err != nil {
+		return nil, fmt.Errorf("initialize light client: %w", err)
+	}
+	block, err := lc.VerifyLightBlockAtHeight(ctx, height, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("verify header %d: %w", height, err)
+	}
+	return block.AppHash, nil
+}
+
+// waitForHeight blocks until the node of clientCtx has committed height.
+func waitForHeight(ctx context.Context, clientCtx client.Context, height int64) error {
+	for {
+		latest, err := latestHeight(ctx, clientCtx)
+		if err != nil {
+			return err
+		}
+		if latest >= height {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("waiting for height %d: %w", height, ctx.Err())
+		case <-time.After(heightPollInterval):
+		}
+	}
+}
+
+func latestHeight(ctx context.Context, clientCtx client.Context) (int64, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	st, err := node.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return st.SyncInfo.LatestBlockHeight, nil
+}
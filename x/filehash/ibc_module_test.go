@@ -0,0 +1,179 @@
+package filehash_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/app"
+	"doctorium/x/filehash"
+	"doctorium/x/filehash/types"
+)
+
+// setupPath starts two doctorium chains and opens a filehash channel between
+// them.
+func setupPath(t *testing.T) (*ibctesting.Coordinator, *ibctesting.Path) {
+	t.Helper()
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		opts := simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}
+		a := app.NewDoctoriumApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, opts).(*app.App)
+		return a, app.NewDefaultGenesisState(a.AppCodec())
+	}
+	t.Cleanup(func() { ibctesting.DefaultTestingAppInit = ibctesting.SetupTestingApp })
+
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	path := ibctesting.NewPath(coordinator.GetChain(ibctesting.GetChainID(1)), coordinator.GetChain(ibctesting.GetChainID(2)))
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = types.PortID
+		endpoint.ChannelConfig.Version = types.Version
+		endpoint.ChannelConfig.Order = channeltypes.UNORDERED
+	}
+	coordinator.Setup(path)
+	return coordinator, path
+}
+
+// relay sends msgs on the source chain of path, receives the packet they send
+// on the counterparty and acknowledges it back. It returns the packet and the
+// acknowledgement written by the counterparty.
+func relay(t *testing.T, path *ibctesting.Path, msg sdk.Msg) (channeltypes.Packet, channeltypes.Acknowledgement) {
+	t.Helper()
+	res, err := path.EndpointA.Chain.SendMsgs(msg)
+	require.NoError(t, err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	require.NoError(t, err)
+
+	require.NoError(t, path.EndpointB.UpdateClient())
+	res, err = path.EndpointB.RecvPacketWithResult(packet)
+	require.NoError(t, err)
+	ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	require.NoError(t, err)
+	require.NoError(t, path.EndpointA.AcknowledgePacket(packet, ackBz))
+
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+	return packet, ack
+}
+
+func registerFile(t *testing.T, chain *ibctesting.TestChain, content string) string {
+	t.Helper()
+	sum := sha256.Sum256([]byte(content))
+	hash := hex.EncodeToString(sum[:])
+	_, err := chain.SendMsgs(&types.MsgUploadFile{
+		Creator:       chain.SenderAccount.GetAddress().String(),
+		FileHash:      hash,
+		HashAlgorithm: types.DefaultHashAlgorithm,
+	})
+	require.NoError(t, err)
+	return hash
+}
+
+func TestAttestationRequestRoundTrip(t *testing.T) {
+	coordinator, path := setupPath(t)
+	chainA, chainB := path.EndpointA.Chain, path.EndpointB.Chain
+	timeout := uint64(coordinator.CurrentTime.Add(time.Hour).UnixNano())
+
+	registered := registerFile(t, chainB, "discharge summary")
+	require.NoError(t, path.EndpointA.UpdateClient())
+
+	for _, tc := range []struct {
+		name       string
+		hash       string
+		registered bool
+	}{
+		{"registered", registered, true},
+		{"unknown", hex.EncodeToString(make([]byte, sha256.Size)), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			packet, ack := relay(t, path, &types.MsgRequestAttestation{
+				Sender:           chainA.SenderAccount.GetAddress().String(),
+				SourceChannel:    path.EndpointA.ChannelID,
+				FileHash:         tc.hash,
+				TimeoutTimestamp: timeout,
+			})
+			data, err := types.UnmarshalPacketData(packet.GetData())
+			require.NoError(t, err)
+			require.Equal(t, tc.hash, data.GetAttestationRequest().FileHash)
+
+			require.True(t, ack.Success(), ack.GetError())
+			result, err := types.UnmarshalAttestationAck(ack.GetResult())
+			require.NoError(t, err)
+			require.Equal(t, tc.hash, result.FileHash)
+			require.Equal(t, tc.registered, result.Registered)
+			if tc.registered {
+				require.Equal(t, chainB.SenderAccount.GetAddress().String(), result.Record.Creator)
+			} else {
+				require.Nil(t, result.Record)
+			}
+		})
+	}
+}
+
+func TestFileRegisteredRoundTrip(t *testing.T) {
+	coordinator, path := setupPath(t)
+	chainA := path.EndpointA.Chain
+
+	hash := registerFile(t, chainA, "radiology report")
+	packet, ack := relay(t, path, &types.MsgRelayFileRegistered{
+		Sender:           chainA.SenderAccount.GetAddress().String(),
+		SourceChannel:    path.EndpointA.ChannelID,
+		FileHash:         hash,
+		TimeoutTimestamp: uint64(coordinator.CurrentTime.Add(time.Hour).UnixNano()),
+	})
+
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	require.NoError(t, err)
+	record := data.GetFileRegistered().Record
+	require.Equal(t, hash, record.FileHash)
+	require.Equal(t, chainA.SenderAccount.GetAddress().String(), record.Creator)
+	require.True(t, ack.Success(), ack.GetError())
+
+}
+
+// TestRecvInvalidFileRegistered checks that an announced record failing
+// validation is answered with an error acknowledgement.
+func TestRecvInvalidFileRegistered(t *testing.T) {
+	_, path := setupPath(t)
+	chainB := path.EndpointB.Chain
+	module := filehash.NewIBCModule(chainB.App.(*app.App).FileHashKeeper)
+
+	sum := sha256.Sum256([]byte("radiology report"))
+	valid := &types.FileRecord{
+		FileHash:      hex.EncodeToString(sum[:]),
+		Creator:       path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		Owner:         path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		HashAlgorithm: types.DefaultHashAlgorithm,
+	}
+	invalidHash := *valid
+	invalidHash.FileHash = "not-a-digest"
+	noCreator := *valid
+	noCreator.Creator = ""
+
+	for name, tc := range map[string]struct {
+		record *types.FileRecord
+		ok     bool
+	}{
+		"valid":        {valid, true},
+		"invalid hash": {&invalidHash, false},
+		"no creator":   {&noCreator, false},
+	} {
+		bz, err := types.NewFileRegisteredPacketData(tc.record).GetBytes()
+		require.NoError(t, err, name)
+		packet := channeltypes.NewPacket(bz, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), uint64(time.Now().Add(time.Hour).UnixNano()))
+
+		ack := module.OnRecvPacket(chainB.GetContext(), packet, nil)
+		require.Equal(t, tc.ok, ack.Success(), name)
+	}
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"doctorium/x/filehash/types"
+)
+
+// AnchorMerkleRoot implements the Msg/AnchorMerkleRoot method. An anchor
+// counts as a single upload towards the per-block limit and is not rewarded.
+func (k Keeper) AnchorMerkleRoot(goCtx context.Context, msg *types.MsgAnchorMerkleRoot) (*types.MsgAnchorMerkleRootResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.UploadEnabled {
+		return nil, types.ErrUploadsDisabled
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if k.IsBlockedAccount(ctx, addr) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAccount, "%s", msg.Creator)
+	}
+	if limit := params.MaxUploadsPerBlock; limit > 0 && k.incrementUploadCount(ctx, addr) > uint64(limit) {
+		return nil, sdkerrors.Wrapf(types.ErrUploadLimitExceeded, "%s may register at most %d files per block", msg.Creator, limit)
+	}
+
+	root, err := types.NormalizeMerkleRoot(msg.TreeAlgorithm, msg.Root)
+	if err != nil {
+		return nil, err
+	}
+	if k.HasAnchoredRoot(ctx, root) {
+		return nil, sdkerrors.Wrap(types.ErrRootAlreadyAnchored, root)
+	}
+
+	anchored := &types.AnchoredRoot{
+		Root:          root,
+		Creator:       msg.Creator,
+		LeafCount:     msg.LeafCount,
+		TreeAlgorithm: msg.TreeAlgorithm,
+		Label:         msg.Label,
+		BlockHeight:   ctx.BlockHeight(),
+		BlockTime:     ctx.BlockTime(),
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		anchored.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+	k.SetAnchoredRoot(ctx, anchored)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRootAnchored,
+		sdk.NewAttribute(types.AttributeKeyRoot, root),
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyLeafCount, strconv.FormatUint(msg.LeafCount, 10)),
+		sdk.NewAttribute(types.AttributeKeyTreeAlgorithm, msg.TreeAlgorithm),
+	))
+	return &types.MsgAnchorMerkleRootResponse{}, nil
+}
+
+// GetAnchoredRoot returns the anchored merkle root, if any.
+func (k Keeper) GetAnchoredRoot(ctx sdk.Context, root string) (*types.AnchoredRoot, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AnchoredRootKey(root))
+	if bz == nil {
+		return nil, false
+	}
+	var anchored types.AnchoredRoot
+	k.cdc.MustUnmarshal(bz, &anchored)
+	return &anchored, true
+}
+
+// HasAnchoredRoot reports whether root has been anchored.
+func (k Keeper) HasAnchoredRoot(ctx sdk.Context, root string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.AnchoredRootKey(root))
+}
+
+// SetAnchoredRoot stores an anchored merkle root.
+func (k Keeper) SetAnchoredRoot(ctx sdk.Context, anchored *types.AnchoredRoot) {
+	ctx.KVStore(k.storeKey).Set(types.AnchoredRootKey(anchored.Root), k.cdc.MustMarshal(anchored))
+}
+
+// IterateAnchoredRoots calls cb for every anchored root in root order until
+// cb returns true.
+func (k Keeper) IterateAnchoredRoots(ctx sdk.Context, cb func(anchored *types.AnchoredRoot) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AnchoredRootPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var anchored types.AnchoredRoot
+		k.cdc.MustUnmarshal(iter.Value(), &anchored)
+		if cb(&anchored) {
+			break
+		}
+	}
+}
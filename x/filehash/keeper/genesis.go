@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"doctorium/x/filehash/types"
+)
+
+// InitGenesis writes the params and every file record of the genesis state
+// into the store together with their ownership histories, the anchored
+// merkle roots and the v1 entries kept by the v2 migration, rebuilds the total of minted rewards from the records and
+// binds the module's IBC port.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+	total := sdk.NewCoins()
+	for _, record := range gs.Files {
+		k.SetFileRecord(ctx, record)
+		reward, err := record.RewardCoins()
+		if err != nil {
+			panic(err)
+		}
+		total = total.Add(reward...)
+	}
+	k.setTotalRewards(ctx, total)
+	for _, history := range gs.OwnershipHistory {
+		k.SetOwnershipHistory(ctx, history)
+	}
+	for _, anchored := range gs.AnchoredRoots {
+		k.SetAnchoredRoot(ctx, anchored)
+	}
+	for _, entry := range gs.LegacyEntries {
+		k.SetLegacyEntry(ctx, entry)
+	}
+
+	if !k.IsBound(ctx, types.PortID) {
+		if err := k.BindPort(ctx, types.PortID); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+}
+
+// ExportGenesis returns the filehash module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	gs := types.DefaultGenesis()
+	gs.Params = k.GetParams(ctx)
+	k.IterateFileRecords(ctx, func(record *types.FileRecord) bool {
+		gs.Files = append(gs.Files, record)
+		return false
+	})
+	k.IterateOwnershipHistories(ctx, func(history *types.OwnershipHistory) bool {
+		gs.OwnershipHistory = append(gs.OwnershipHistory, history)
+		return false
+	})
+	k.IterateAnchoredRoots(ctx, func(anchored *types.AnchoredRoot) bool {
+		gs.AnchoredRoots = append(gs.AnchoredRoots, anchored)
+		return false
+	})
+	k.IterateLegacyEntries(ctx, func(entry *types.LegacyEntry) bool {
+		gs.LegacyEntries = append(gs.LegacyEntries, entry)
+		return false
+	})
+	return gs
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/keeper"
+	"doctorium/x/filehash/types"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	f := setupKeeper(t)
+
+	alice := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	bob := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	blockTime := time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC)
+	var (
+		v1   = strings.Repeat("0a", 32)
+		v2   = strings.Repeat("0b", 32)
+		lost = strings.Repeat("0c", 32)
+	)
+
+	params := types.DefaultParams()
+	params.MaxUploadsPerBlock = 7
+	genesis := &types.GenesisState{
+		Params: params,
+		Files: []*types.FileRecord{
+			{
+				FileHash:      v1,
+				Creator:       alice,
+				Owner:         alice,
+				HashAlgorithm: types.DefaultHashAlgorithm,
+				Size_:         1024,
+				MimeType:      "application/dicom",
+				BlockHeight:   10,
+				BlockTime:     blockTime,
+				Label:         "scan",
+				Reward:        "10stake",
+				Status:        types.FileStatus_FILE_STATUS_SUPERSEDED,
+				SupersededBy:  v2,
+				StatusHeight:  12,
+			},
+			{
+				FileHash:      v2,
+				Creator:       alice,
+				Owner:         bob,
+				HashAlgorithm: types.DefaultHashAlgorithm,
+				BlockHeight:   12,
+				BlockTime:     blockTime.Add(time.Minute),
+				Reward:        "10stake",
+				Supersedes:    v1,
+			},
+			{
+				FileHash:         lost,
+				Creator:          alice,
+				Owner:            alice,
+				HashAlgorithm:    types.DefaultHashAlgorithm,
+				BlockHeight:      11,
+				BlockTime:        blockTime,
+				Status:           types.FileStatus_FILE_STATUS_REVOKED,
+				RevocationReason: types.RevocationReason_REVOCATION_REASON_ISSUED_IN_ERROR,
+				RevocationNote:   "wrong patient",
+				StatusHeight:     13,
+			},
+		},
+		OwnershipHistory: []*types.OwnershipHistory{
+			{
+				FileHash: v2,
+				Transfers: []*types.OwnershipTransfer{
+					{From: alice, To: bob, BlockHeight: 14, BlockTime: blockTime.Add(2 * time.Minute)},
+				},
+			},
+		},
+		AnchoredRoots: []*types.AnchoredRoot{
+			{
+				Root:          strings.Repeat("1f", 32),
+				Creator:       alice,
+				LeafCount:     3,
+				TreeAlgorithm: "rfc6962-sha256",
+				Label:         "export 2024-05-01",
+				BlockHeight:   15,
+				BlockTime:     blockTime.Add(3 * time.Minute),
+			},
+		},
+		LegacyEntries: []*types.LegacyEntry{
+			{Key: []byte("not-a-hash"), Value: []byte(alice), Reason: "invalid file hash"},
+		},
+	}
+	require.NoError(t, types.ValidateGenesis(genesis))
+
+	f.keeper.InitGenesis(f.ctx, genesis)
+	require.True(t, f.keeper.IsBound(f.ctx, types.PortID))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 20)), f.keeper.GetTotalRewards(f.ctx))
+
+	exported := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, types.ValidateGenesis(exported))
+	require.Equal(t, genesis.Params, exported.Params)
+	require.Equal(t, genesis.Files, exported.Files)
+	require.Equal(t, genesis.OwnershipHistory, exported.OwnershipHistory)
+	require.Equal(t, genesis.AnchoredRoots, exported.AnchoredRoots)
+	require.Equal(t, genesis.LegacyEntries, exported.LegacyEntries)
+
+	// v2 was registered by alice and transferred to bob
+	aliceAddr, bobAddr := sdk.MustAccAddressFromBech32(alice), sdk.MustAccAddressFromBech32(bob)
+	store := f.ctx.KVStore(f.storeKey)
+	require.True(t, store.Has(types.CreatorIndexKey(aliceAddr, v2)))
+	require.True(t, store.Has(types.OwnerIndexKey(bobAddr, v2)))
+	require.False(t, store.Has(types.OwnerIndexKey(aliceAddr, v2)))
+
+	msg, broken := keeper.AllInvariants(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+
+	// the history must end with the owner, not the creator
+	genesis.Files[1].Owner = alice
+	require.ErrorContains(t, types.ValidateGenesis(genesis), "ends with")
+}
@@ -0,0 +1,192 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"doctorium/x/filehash/types"
+)
+
+// FileList implements the Query/FileList gRPC method.
+func (k Keeper) FileList(goCtx context.Context, req *types.QueryFileListRequest) (*types.QueryFileListResponse, error) {
+	return k.GetAllFiles(sdk.UnwrapSDKContext(goCtx), req)
+}
+
+// GetAllFiles returns a page of registered file records.
+func (k Keeper) GetAllFiles(ctx sdk.Context, req *types.QueryFileListRequest) (*types.QueryFileListResponse, error) {
+	fileStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.FileKeyPrefix)
+	resp := &types.QueryFileListResponse{}
+	pageRes, err := query.Paginate(fileStore, req.Pagination, func(_ []byte, value []byte) error {
+		var record types.FileRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		resp.Files = append(resp.Files, &record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
+
+// File implements the Query/File gRPC method. Proofs are not available over
+// gRPC; they are served by ABCI store queries only, see utils.QueryFileABCI.
+func (k Keeper) File(goCtx context.Context, req *types.QueryFileRequest) (*types.QueryFileResponse, error) {
+	if req == nil || req.FileHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "file hash cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// 저장된 해시는 항상 소문자 정규형이다
+	record, found := k.GetFileRecord(ctx, strings.ToLower(req.FileHash))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "file %s not found", req.FileHash)
+	}
+	return &types.QueryFileResponse{File: record, Versions: k.GetVersionChain(ctx, record)}, nil
+}
+
+// FilesByCreator implements the Query/FilesByCreator gRPC method.
+func (k Keeper) FilesByCreator(goCtx context.Context, req *types.QueryFilesByCreatorRequest) (*types.QueryFilesByCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreatorIndexKeyPrefix(creator))
+	resp := &types.QueryFilesByCreatorResponse{}
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		record, found := k.GetFileRecord(ctx, string(key))
+		if !found {
+			return status.Errorf(codes.Internal, "indexed file %s not found", key)
+		}
+		resp.Files = append(resp.Files, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
+
+// FilesByOwner implements the Query/FilesByOwner gRPC method.
+func (k Keeper) FilesByOwner(goCtx context.Context, req *types.QueryFilesByOwnerRequest) (*types.QueryFilesByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OwnerIndexKeyPrefix(owner))
+	resp := &types.QueryFilesByOwnerResponse{}
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		record, found := k.GetFileRecord(ctx, string(key))
+		if !found {
+			return status.Errorf(codes.Internal, "indexed file %s not found", key)
+		}
+		resp.Files = append(resp.Files, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
+
+// OwnershipHistory implements the Query/OwnershipHistory gRPC method.
+func (k Keeper) OwnershipHistory(goCtx context.Context, req *types.QueryOwnershipHistoryRequest) (*types.QueryOwnershipHistoryResponse, error) {
+	if req == nil || req.FileHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "file hash cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	hash := strings.ToLower(req.FileHash)
+	if !k.HasFileHash(ctx, hash) {
+		return nil, status.Errorf(codes.NotFound, "file %s not found", req.FileHash)
+	}
+	return &types.QueryOwnershipHistoryResponse{Transfers: k.GetOwnershipHistory(ctx, hash).Transfers}, nil
+}
+
+// AnchoredRoot implements the Query/AnchoredRoot gRPC method.
+func (k Keeper) AnchoredRoot(goCtx context.Context, req *types.QueryAnchoredRootRequest) (*types.QueryAnchoredRootResponse, error) {
+	if req == nil || req.Root == "" {
+		return nil, status.Error(codes.InvalidArgument, "root cannot be empty")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	anchored, found := k.GetAnchoredRoot(ctx, strings.ToLower(req.Root))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "root %s not anchored", req.Root)
+	}
+	return &types.QueryAnchoredRootResponse{AnchoredRoot: anchored}, nil
+}
+
+// AnchoredRoots implements the Query/AnchoredRoots gRPC method.
+func (k Keeper) AnchoredRoots(goCtx context.Context, req *types.QueryAnchoredRootsRequest) (*types.QueryAnchoredRootsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rootStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AnchoredRootPrefix)
+	resp := &types.QueryAnchoredRootsResponse{}
+	pageRes, err := query.Paginate(rootStore, req.Pagination, func(_ []byte, value []byte) error {
+		var anchored types.AnchoredRoot
+		if err := k.cdc.Unmarshal(value, &anchored); err != nil {
+			return err
+		}
+		resp.AnchoredRoots = append(resp.AnchoredRoots, &anchored)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
+
+// LegacyEntries implements the Query/LegacyEntries gRPC method.
+func (k Keeper) LegacyEntries(goCtx context.Context, req *types.QueryLegacyEntriesRequest) (*types.QueryLegacyEntriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	legacyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LegacyEntryPrefix)
+	resp := &types.QueryLegacyEntriesResponse{}
+	pageRes, err := query.Paginate(legacyStore, req.Pagination, func(_ []byte, value []byte) error {
+		var entry types.LegacyEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		resp.LegacyEntries = append(resp.LegacyEntries, &entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Pagination = pageRes
+	return resp, nil
+}
+
+// Params implements the Query/Params gRPC method.
+func (k Keeper) Params(goCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"doctorium/x/filehash/types"
+)
+
+// IsBound checks if the module already owns the capability of portID.
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// BindPort binds the module to portID and claims the returned capability.
+func (k Keeper) BindPort(ctx sdk.Context, portID string) error {
+	capability := k.portKeeper.BindPort(ctx, portID)
+	return k.ClaimCapability(ctx, capability, host.PortPath(portID))
+}
+
+// ClaimCapability claims a capability handed to the module by IBC core.
+func (k Keeper) ClaimCapability(ctx sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, capability, name)
+}
+
+// RequestAttestation sends an attestation request for a document hash over a
+// filehash channel.
+func (k Keeper) RequestAttestation(goCtx context.Context, msg *types.MsgRequestAttestation) (*types.MsgRequestAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	data := types.NewAttestationRequestPacketData(strings.ToLower(msg.FileHash))
+	sequence, err := k.sendPacket(ctx, msg.SourceChannel, msg.TimeoutTimestamp, data)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRequestAttestationResponse{Sequence: sequence}, nil
+}
+
+// RelayFileRegistered sends the record of a locally registered document over a
+// filehash channel.
+func (k Keeper) RelayFileRegistered(goCtx context.Context, msg *types.MsgRelayFileRegistered) (*types.MsgRelayFileRegisteredResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, found := k.GetFileRecord(ctx, strings.ToLower(msg.FileHash))
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrFileNotFound, msg.FileHash)
+	}
+
+	sequence, err := k.sendPacket(ctx, msg.SourceChannel, msg.TimeoutTimestamp, types.NewFileRegisteredPacketData(record))
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRelayFileRegisteredResponse{Sequence: sequence}, nil
+}
+
+// sendPacket sends data over the given channel of the filehash port. Packets
+// only carry a timestamp timeout.
+func (k Keeper) sendPacket(ctx sdk.Context, channelID string, timeoutTimestamp uint64, data *types.FilehashPacketData) (uint64, error) {
+	if _, found := k.channelKeeper.GetChannel(ctx, types.PortID, channelID); !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", types.PortID, channelID)
+	}
+
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(types.PortID, channelID))
+	if !ok {
+		return 0, sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	bz, err := data.GetBytes()
+	if err != nil {
+		return 0, err
+	}
+	return k.channelKeeper.SendPacket(ctx, chanCap, types.PortID, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, bz)
+}
+
+// OnRecvPacket handles a packet received over a filehash channel and returns
+// the acknowledgement result. Attestation requests are answered with an
+// AttestationAck; announced registrations are validated and reported as
+// events, and a malformed record is answered with an error.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data *types.FilehashPacketData) ([]byte, error) {
+	switch p := data.Packet.(type) {
+	case *types.FilehashPacketData_AttestationRequest:
+		hash := strings.ToLower(p.AttestationRequest.FileHash)
+		record, found := k.GetFileRecord(ctx, hash)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRecvAttestationRequest,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeyFileHash, hash),
+			sdk.NewAttribute(types.AttributeKeyRegistered, strconv.FormatBool(found)),
+		))
+
+		ack := &types.AttestationAck{FileHash: hash, Registered: found, Record: record}
+		return ack.GetBytes()
+
+	case *types.FilehashPacketData_FileRegistered:
+		record := p.FileRegistered.Record
+		if err := record.Validate(); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidPacket, err.Error())
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRecvFileRegistered,
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeyFileHash, record.FileHash),
+			sdk.NewAttribute(types.AttributeKeyCreator, record.Creator),
+			sdk.NewAttribute(types.AttributeKeyHashAlgorithm, record.HashAlgorithm),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(record.BlockHeight, 10)),
+		))
+		return []byte{byte(1)}, nil
+
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalidPacket, "unknown packet type %T", p)
+	}
+}
+
+// OnAcknowledgementPacket reports the outcome of a packet sent by this chain.
+// The answer to an attestation request is emitted as a file_attestation event.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data *types.FilehashPacketData, ack channeltypes.Acknowledgement) error {
+	switch p := data.Packet.(type) {
+	case *types.FilehashPacketData_AttestationRequest:
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyFileHash, p.AttestationRequest.FileHash),
+		}
+		if !ack.Success() {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyAckError, ack.GetError()))
+		} else {
+			result, err := types.UnmarshalAttestationAck(ack.GetResult())
+			if err != nil {
+				return err
+			}
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRegistered, strconv.FormatBool(result.Registered)))
+			if result.Record != nil {
+				attrs = append(attrs,
+					sdk.NewAttribute(types.AttributeKeyCreator, result.Record.Creator),
+					sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(result.Record.BlockHeight, 10)),
+				)
+			}
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFileAttestation, attrs...))
+
+	case *types.FilehashPacketData_FileRegistered:
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeyFileHash, p.FileRegistered.Record.FileHash),
+		}
+		if !ack.Success() {
+			attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyAckError, ack.GetError()))
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeFileRelayAck, attrs...))
+	}
+	return nil
+}
+
+// OnTimeoutPacket reports a packet that timed out before being received.
+// Nothing was escrowed, so there is no state to revert.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTimeout,
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+		sdk.NewAttribute(channeltypes.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+	))
+	return nil
+}
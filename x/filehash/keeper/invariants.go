@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"doctorium/x/filehash/types"
+)
+
+// RegisterInvariants registers all filehash invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "creator-index", CreatorIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "owner-index", OwnerIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-rewards", TotalRewardsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+}
+
+// AllInvariants runs all invariants of the filehash module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := CreatorIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = OwnerIndexInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = TotalRewardsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleAccountInvariant(k)(ctx)
+	}
+}
+
+// CreatorIndexInvariant checks that every creator index entry points to an
+// existing record of that creator and that every record is indexed.
+func CreatorIndexInvariant(k Keeper) sdk.Invariant {
+	return addressIndexInvariant(k, "creator", types.CreatorIndexPrefix, types.ParseCreatorIndexKey, types.CreatorIndexKey,
+		func(record *types.FileRecord) string { return record.Creator })
+}
+
+// OwnerIndexInvariant checks that every owner index entry points to an
+// existing record currently owned by that address and that every record is
+// indexed under its owner.
+func OwnerIndexInvariant(k Keeper) sdk.Invariant {
+	return addressIndexInvariant(k, "owner", types.OwnerIndexPrefix, types.ParseOwnerIndexKey, types.OwnerIndexKey,
+		func(record *types.FileRecord) string { return record.Owner })
+}
+
+// addressIndexInvariant checks an index of records by the address returned by
+// addressOf, stored under prefix.
+func addressIndexInvariant(
+	k Keeper,
+	role string,
+	prefix []byte,
+	parseKey func([]byte) (sdk.AccAddress, string),
+	indexKey func(sdk.AccAddress, string) []byte,
+	addressOf func(*types.FileRecord) string,
+) sdk.Invariant {
+	route := role + "-index"
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+		store := ctx.KVStore(k.storeKey)
+
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			addr, hash := parseKey(iter.Key())
+			record, found := k.GetFileRecord(ctx, hash)
+			switch {
+			case !found:
+				broken++
+				msg += fmt.Sprintf("\tindex entry of %s points to missing record %s\n", addr, hash)
+			case addressOf(record) != addr.String():
+				broken++
+				msg += fmt.Sprintf("\tindex entry of %s points to record %s of %s %s\n", addr, hash, role, addressOf(record))
+			}
+		}
+
+		k.IterateFileRecords(ctx, func(record *types.FileRecord) bool {
+			addr, err := sdk.AccAddressFromBech32(addressOf(record))
+			if err != nil || !store.Has(indexKey(addr, record.FileHash)) {
+				broken++
+				msg += fmt.Sprintf("\trecord %s is not indexed under its %s %s\n", record.FileHash, role, addressOf(record))
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, route,
+			fmt.Sprintf("%d inconsistent %s index entries found\n%s", broken, role, msg)), broken != 0
+	}
+}
+
+// TotalRewardsInvariant checks that the total of minted rewards equals the
+// sum of the rewards recorded on every file, i.e. the reward times the number
+// of files registered under each reward setting.
+func TotalRewardsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+			sum    = sdk.NewCoins()
+		)
+
+		k.IterateFileRecords(ctx, func(record *types.FileRecord) bool {
+			reward, err := record.RewardCoins()
+			if err != nil {
+				broken = true
+				msg += fmt.Sprintf("\trecord %s has an invalid reward %q: %s\n", record.FileHash, record.Reward, err)
+				return false
+			}
+			sum = sum.Add(reward...)
+			return false
+		})
+
+		// IsEqual은 denom이 다르면 패닉하므로 양방향 IsAllGTE로 비교한다
+		total := k.GetTotalRewards(ctx)
+		if !sum.IsAllGTE(total) || !total.IsAllGTE(sum) {
+			broken = true
+			msg += fmt.Sprintf("\tsum of record rewards %s does not match total minted %s\n", sum, total)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total-rewards", msg), broken
+	}
+}
+
+// ModuleAccountInvariant checks that the module account holds no residual
+// balance: rewards are minted to it and paid out in the same transaction.
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account",
+			fmt.Sprintf("\tmodule account %s holds residual balance %s\n", moduleAddr, balance)), !balance.IsZero()
+	}
+}
@@ -0,0 +1,33 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/keeper"
+	"doctorium/x/filehash/types"
+)
+
+func TestTotalRewardsInvariantDenomMismatch(t *testing.T) {
+	f := setupKeeper(t)
+	creator := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	_, err := f.keeper.UploadFile(sdk.WrapSDKContext(f.ctx), &types.MsgUploadFile{
+		Creator: creator.String(), FileHash: strings.Repeat("0c", 32), HashAlgorithm: types.DefaultHashAlgorithm,
+	})
+	require.NoError(t, err)
+	msg, broken := keeper.TotalRewardsInvariant(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+
+	// a total in another denom than the records reports, rather than panics
+	store := f.ctx.KVStore(f.storeKey)
+	for _, total := range []string{"", "7otherdenom", types.DefaultParams().RewardCoins().Add(sdk.NewInt64Coin("otherdenom", 7)).String()} {
+		store.Set(types.TotalRewardsKey, []byte(total))
+		msg, broken = keeper.TotalRewardsInvariant(f.keeper)(f.ctx)
+		require.True(t, broken, total)
+		require.Contains(t, msg, "does not match total minted")
+	}
+}
@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
 	"doctorium/x/filehash/types"
@@ -19,70 +22,272 @@ type Keeper struct {
 	types.UnimplementedQueryServer
 
 	storeKey   storetypes.StoreKey
+	tkey       storetypes.StoreKey
 	cdc        codec.BinaryCodec
 	bankKeeper bankkeeper.Keeper
+
+	// IBC keepers used by the attestation channel
+	channelKeeper types.ChannelKeeper
+	portKeeper    types.PortKeeper
+	scopedKeeper  types.ScopedKeeper
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the gov module account
+	authority string
 }
 
 // NewKeeper creates a new Keeper instance.
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, bankKeeper bankkeeper.Keeper) Keeper {
-	return Keeper{storeKey: key, cdc: cdc, bankKeeper: bankKeeper}
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	tkey storetypes.StoreKey,
+	bankKeeper bankkeeper.Keeper,
+	channelKeeper types.ChannelKeeper,
+	portKeeper types.PortKeeper,
+	scopedKeeper types.ScopedKeeper,
+	authority string,
+) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid filehash authority address: %s", err))
+	}
+	return Keeper{
+		storeKey:      key,
+		tkey:          tkey,
+		cdc:           cdc,
+		bankKeeper:    bankKeeper,
+		channelKeeper: channelKeeper,
+		portKeeper:    portKeeper,
+		scopedKeeper:  scopedKeeper,
+		authority:     authority,
+	}
 }
 
-// StoreFileHash saves a file hash under the creator address.
-func (k Keeper) StoreFileHash(ctx sdk.Context, creator, hash string) {
+// SetFileRecord saves a file record keyed by its hash and indexes it under
+// its creator and its current owner.
+func (k Keeper) SetFileRecord(ctx sdk.Context, record *types.FileRecord) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte(hash), []byte(creator))
+	store.Set(types.FileKey(record.FileHash), k.cdc.MustMarshal(record))
+
+	creator := sdk.MustAccAddressFromBech32(record.Creator)
+	store.Set(types.CreatorIndexKey(creator, record.FileHash), []byte{})
+	owner := sdk.MustAccAddressFromBech32(record.Owner)
+	store.Set(types.OwnerIndexKey(owner, record.FileHash), []byte{})
+}
+
+// GetFileRecord returns the record registered for the given hash.
+func (k Keeper) GetFileRecord(ctx sdk.Context, hash string) (*types.FileRecord, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.FileKey(hash))
+	if bz == nil {
+		return nil, false
+	}
+	var record types.FileRecord
+	k.cdc.MustUnmarshal(bz, &record)
+	return &record, true
+}
+
+// IterateFileRecords calls cb for every stored record in hash order until cb
+// returns true.
+func (k Keeper) IterateFileRecords(ctx sdk.Context, cb func(record *types.FileRecord) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.FileKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var record types.FileRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(&record) {
+			break
+		}
+	}
 }
 
 // HasFileHash checks if a file hash already exists.
 func (k Keeper) HasFileHash(ctx sdk.Context, hash string) bool {
-	return ctx.KVStore(k.storeKey).Has([]byte(hash))
+	return ctx.KVStore(k.storeKey).Has(types.FileKey(hash))
 }
 
-func (k Keeper) GetAllFiles(ctx sdk.Context, req *types.QueryFileListRequest) (*types.QueryFileListResponse, error) {
-	store := ctx.KVStore(k.storeKey)
-	resp := &types.QueryFileListResponse{}
-	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
-		// value, key 로 만든 FileData 값을 포인터로 만들어 슬라이스에 추가
-		resp.Files = append(resp.Files, &types.FileData{
-			Creator:  string(value),
-			FileHash: string(key),
-		})
-		return nil
+// UploadFile processes a file upload message and mints a reward.
+func (k Keeper) UploadFile(goCtx context.Context, msg *types.MsgUploadFile) (*types.MsgUploadFileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.UploadEnabled {
+		return nil, types.ErrUploadsDisabled
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := k.registerFile(ctx, params, addr, msg.Creator, &types.UploadFileItem{
+		FileHash:      msg.FileHash,
+		Size_:         msg.Size_,
+		MimeType:      msg.MimeType,
+		Label:         msg.Label,
+		HashAlgorithm: msg.HashAlgorithm,
+		Patient:       msg.Patient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	resp.Pagination = pageRes
-	return resp, nil
+
+	coins := params.RewardCoins()
+	if err := k.payReward(ctx, addr, coins); err != nil {
+		return nil, err
+	}
+	if err := k.emitFileRegistered(ctx, record, coins); err != nil {
+		return nil, err
+	}
+	return &types.MsgUploadFileResponse{Success: true}, nil
 }
 
-// UploadFile processes a file upload message and mints a reward.
-func (k Keeper) UploadFile(goCtx context.Context, msg *types.MsgUploadFile) (*types.MsgUploadFileResponse, error) {
+// UploadFiles implements the Msg/UploadFiles method. The rewards of all
+// registered items are minted and paid out in a single step, so a batch costs
+// the per-item store writes plus one payout instead of N full uploads.
+func (k Keeper) UploadFiles(goCtx context.Context, msg *types.MsgUploadFiles) (*types.MsgUploadFilesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.UploadEnabled {
+		return nil, types.ErrUploadsDisabled
+	}
 
-	// Prevent duplicate uploads
-	if k.HasFileHash(ctx, msg.FileHash) {
-		return nil, sdkerrors.Wrap(types.ErrFileAlreadyExists, msg.FileHash)
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, err
 	}
 
-	// Store the hash
-	k.StoreFileHash(ctx, msg.Creator, msg.FileHash)
+	resp := &types.MsgUploadFilesResponse{Results: make([]*types.UploadFileResult, 0, len(msg.Files))}
+	var records []*types.FileRecord
+	for i, item := range msg.Files {
+		var record *types.FileRecord
+		if msg.Atomic {
+			if record, err = k.registerFile(ctx, params, addr, msg.Creator, item); err != nil {
+				return nil, sdkerrors.Wrapf(err, "file %d", i)
+			}
+		} else {
+			// 실패한 항목의 쓰기만 버리도록 항목마다 캐시 컨텍스트에서 등록한다
+			cacheCtx, write := ctx.CacheContext()
+			if record, err = k.registerFile(cacheCtx, params, addr, msg.Creator, item); err == nil {
+				write()
+			}
+		}
 
-	// Mint and send reward coins
-	coins := sdk.NewCoins(sdk.NewInt64Coin("drt", 10))
-	// Mint into module account
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		result := &types.UploadFileResult{FileHash: item.FileHash}
+		if hash, normErr := types.NormalizeFileHash(item.HashAlgorithm, item.FileHash); normErr == nil {
+			result.FileHash = hash
+		}
+		if err != nil {
+			result.Duplicate = errors.Is(err, types.ErrFileAlreadyExists)
+			result.Error = err.Error()
+		} else {
+			result.Registered = true
+			records = append(records, record)
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	reward := params.RewardCoins()
+	// Coins.MulInt panics on zero, which a batch without any registered item hits
+	total := sdk.NewCoins()
+	if len(records) > 0 {
+		total = reward.MulInt(sdk.NewInt(int64(len(records))))
+	}
+	if err := k.payReward(ctx, addr, total); err != nil {
 		return nil, err
 	}
-	// Send from module to user
-	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	for _, record := range records {
+		if err := k.emitFileRegistered(ctx, record, reward); err != nil {
+			return nil, err
+		}
+	}
+
+	resp.Registered = uint32(len(records))
+	resp.Reward = total.String()
+	return resp, nil
+}
+
+// registerFile validates and stores a single document registration of
+// creator. It rejects blocked accounts, enforces the per-block upload limit
+// and rejects duplicates; the reward is recorded on the record but paid by the
+// caller.
+func (k Keeper) registerFile(ctx sdk.Context, params *types.Params, addr sdk.AccAddress, creator string, item *types.UploadFileItem) (*types.FileRecord, error) {
+	// 앤티 핸들러를 거치지 않는 경로(거버넌스 제안, 다른 모듈의 직접 호출 등)도 막는다
+	if k.IsBlockedAccount(ctx, addr) {
+		return nil, sdkerrors.Wrapf(types.ErrBlockedAccount, "%s", creator)
+	}
+	if limit := params.MaxUploadsPerBlock; limit > 0 && k.incrementUploadCount(ctx, addr) > uint64(limit) {
+		return nil, sdkerrors.Wrapf(types.ErrUploadLimitExceeded, "%s may register at most %d files per block", creator, limit)
+	}
+
+	// Compare hashes in their canonical form so differently cased
+	// submissions of the same digest are caught as duplicates
+	hash, err := types.NormalizeFileHash(item.HashAlgorithm, item.FileHash)
 	if err != nil {
 		return nil, err
 	}
+
+	// Prevent duplicate uploads
+	if k.HasFileHash(ctx, hash) {
+		return nil, sdkerrors.Wrap(types.ErrFileAlreadyExists, hash)
+	}
+
+	// Store the record
+	record := &types.FileRecord{
+		FileHash:      hash,
+		Creator:       creator,
+		Owner:         creator,
+		Patient:       item.Patient,
+		HashAlgorithm: item.HashAlgorithm,
+		Size_:         item.Size_,
+		MimeType:      item.MimeType,
+		BlockHeight:   ctx.BlockHeight(),
+		BlockTime:     ctx.BlockTime(),
+		Label:         item.Label,
+		Reward:        params.RewardCoins().String(),
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		record.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+	k.SetFileRecord(ctx, record)
+	return record, nil
+}
+
+// payReward mints coins and sends them to addr.
+func (k Keeper) payReward(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+	// Mint into module account
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+	// Send from module to user
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
-		return nil, err
+		return err
 	}
+	k.addTotalRewards(ctx, coins)
+	return nil
+}
 
-	return &types.MsgUploadFileResponse{Success: true}, nil
+// emitFileRegistered emits the typed EventFileRegistered together with its
+// plain file_registered counterpart.
+func (k Keeper) emitFileRegistered(ctx sdk.Context, record *types.FileRecord, reward sdk.Coins) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFileRegistered{
+		FileHash:      record.FileHash,
+		Creator:       record.Creator,
+		HashAlgorithm: record.HashAlgorithm,
+		Height:        record.BlockHeight,
+		Reward:        reward.String(),
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFileRegistered,
+		sdk.NewAttribute(types.AttributeKeyFileHash, record.FileHash),
+		sdk.NewAttribute(types.AttributeKeyCreator, record.Creator),
+		sdk.NewAttribute(types.AttributeKeyHashAlgorithm, record.HashAlgorithm),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(record.BlockHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+	))
+	return nil
 }
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/keeper"
+	"doctorium/x/filehash/types"
+)
+
+// testFixture is a filehash keeper on an in-memory store. The IBC channel
+// keeper is left out, so it only serves code paths that send no packets; bank
+// and port capabilities are faked.
+type testFixture struct {
+	ctx      sdk.Context
+	keeper   keeper.Keeper
+	storeKey storetypes.StoreKey
+	cdc      codec.Codec
+	bank     *fakeBank
+}
+
+func setupKeeper(t *testing.T) testFixture {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	tkey := sdk.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, tkey)
+
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	caps := &fakeCapabilities{caps: make(map[string]*capabilitytypes.Capability)}
+	bank := &fakeBank{balances: make(map[string]sdk.Coins), blocked: make(map[string]bool)}
+	k := keeper.NewKeeper(cdc, key, tkey, bank, nil, caps, caps, authority)
+	return testFixture{ctx: testCtx.Ctx, keeper: k, storeKey: key, cdc: cdc, bank: bank}
+}
+
+// fakeCapabilities stands in for the port and scoped capability keepers.
+type fakeCapabilities struct {
+	caps map[string]*capabilitytypes.Capability
+}
+
+func (f *fakeCapabilities) BindPort(_ sdk.Context, _ string) *capabilitytypes.Capability {
+	return capabilitytypes.NewCapability(uint64(len(f.caps)) + 1)
+}
+
+func (f *fakeCapabilities) GetCapability(_ sdk.Context, name string) (*capabilitytypes.Capability, bool) {
+	capability, ok := f.caps[name]
+	return capability, ok
+}
+
+func (f *fakeCapabilities) AuthenticateCapability(_ sdk.Context, capability *capabilitytypes.Capability, name string) bool {
+	return f.caps[name] == capability
+}
+
+func (f *fakeCapabilities) ClaimCapability(_ sdk.Context, capability *capabilitytypes.Capability, name string) error {
+	f.caps[name] = capability
+	return nil
+}
+
+// fakeBank implements the bank methods the keeper uses on plain balances.
+// Calling any other method panics.
+type fakeBank struct {
+	bankkeeper.Keeper
+
+	balances map[string]sdk.Coins
+	blocked  map[string]bool
+}
+
+func (b *fakeBank) BlockedAddr(addr sdk.AccAddress) bool {
+	return b.blocked[addr.String()]
+}
+
+func (b *fakeBank) MintCoins(_ sdk.Context, moduleName string, coins sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	b.balances[addr] = b.balances[addr].Add(coins...)
+	return nil
+}
+
+func (b *fakeBank) SendCoinsFromModuleToAccount(_ sdk.Context, moduleName string, to sdk.AccAddress, coins sdk.Coins) error {
+	from := authtypes.NewModuleAddress(moduleName).String()
+	balance, negative := b.balances[from].SafeSub(coins...)
+	if negative {
+		return fmt.Errorf("insufficient funds in %s", moduleName)
+	}
+	b.balances[from] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(coins...)
+	return nil
+}
+
+func (b *fakeBank) GetAllBalances(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func TestUploadFilesPartialFailure(t *testing.T) {
+	f := setupKeeper(t)
+	ctx := sdk.WrapSDKContext(f.ctx)
+	creator := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	existing := strings.Repeat("0a", 32)
+
+	_, err := f.keeper.UploadFile(ctx, &types.MsgUploadFile{
+		Creator: creator.String(), FileHash: existing, HashAlgorithm: types.DefaultHashAlgorithm,
+	})
+	require.NoError(t, err)
+
+	// 등록되는 항목이 하나도 없는 배치도 보상 없이 처리된다
+	res, err := f.keeper.UploadFiles(ctx, &types.MsgUploadFiles{
+		Creator: creator.String(),
+		Files: []*types.UploadFileItem{
+			{FileHash: strings.ToUpper(existing), HashAlgorithm: types.DefaultHashAlgorithm},
+			{FileHash: "zz", HashAlgorithm: types.DefaultHashAlgorithm},
+		},
+	})
+	require.NoError(t, err)
+	require.Zero(t, res.Registered)
+	require.True(t, res.Results[0].Duplicate)
+	require.Equal(t, existing, res.Results[0].FileHash)
+	require.NotEmpty(t, res.Results[1].Error)
+	require.Equal(t, "", res.Reward)
+
+	fresh := strings.Repeat("0b", 32)
+	res, err = f.keeper.UploadFiles(ctx, &types.MsgUploadFiles{
+		Creator: creator.String(),
+		Files: []*types.UploadFileItem{
+			{FileHash: fresh, HashAlgorithm: types.DefaultHashAlgorithm},
+			{FileHash: existing, HashAlgorithm: types.DefaultHashAlgorithm},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), res.Registered)
+	require.True(t, f.keeper.HasFileHash(f.ctx, fresh))
+
+	reward := types.DefaultParams().RewardCoins()
+	require.Equal(t, reward.Add(reward...), f.bank.GetAllBalances(f.ctx, creator))
+	msg, broken := keeper.AllInvariants(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+}
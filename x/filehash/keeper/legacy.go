@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"doctorium/x/filehash/types"
+)
+
+// GetLegacyEntry returns the v1 entry kept under legacyKey, if any.
+func (k Keeper) GetLegacyEntry(ctx sdk.Context, legacyKey []byte) (*types.LegacyEntry, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.LegacyEntryKey(legacyKey))
+	if bz == nil {
+		return nil, false
+	}
+	var entry types.LegacyEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return &entry, true
+}
+
+// SetLegacyEntry stores a v1 entry under the legacy prefix.
+func (k Keeper) SetLegacyEntry(ctx sdk.Context, entry *types.LegacyEntry) {
+	ctx.KVStore(k.storeKey).Set(types.LegacyEntryKey(entry.Key), k.cdc.MustMarshal(entry))
+}
+
+// IterateLegacyEntries calls cb for every kept v1 entry in key order until cb
+// returns true.
+func (k Keeper) IterateLegacyEntries(ctx sdk.Context, cb func(entry *types.LegacyEntry) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.LegacyEntryPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var entry types.LegacyEntry
+		k.cdc.MustUnmarshal(iter.Value(), &entry)
+		if cb(&entry) {
+			break
+		}
+	}
+}
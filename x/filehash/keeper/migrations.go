@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "doctorium/x/filehash/migrations/v2"
+	"doctorium/x/filehash/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. v1 had no IBC, and InitGenesis
+// does not run for migrated modules, so the attestation port is bound here.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := v2.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+	if !m.keeper.IsBound(ctx, types.PortID) {
+		return m.keeper.BindPort(ctx, types.PortID)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/keeper"
+	"doctorium/x/filehash/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := setupKeeper(t)
+	store := f.ctx.KVStore(f.storeKey)
+
+	alice := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	bob := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	var (
+		merged   = strings.Repeat("ab", 32)
+		conflict = strings.Repeat("cd", 32)
+		plain    = strings.Repeat("ef", 32)
+	)
+	record := func(creator sdk.AccAddress, height int64, label, reward string) []byte {
+		return f.cdc.MustMarshal(&types.FileRecord{
+			Creator:       creator.String(),
+			HashAlgorithm: types.DefaultHashAlgorithm,
+			BlockHeight:   height,
+			Label:         label,
+			Reward:        reward,
+		})
+	}
+
+	// v1 루트 키: 해시 그대로 -> creator 주소 또는 FileRecord
+	store.Set([]byte(strings.ToUpper(merged)), []byte(alice.String()))
+	store.Set([]byte(merged), record(alice, 5, "lab report", "10stake"))
+	store.Set([]byte(strings.ToUpper(conflict)), record(alice, 3, "", "1stake"))
+	store.Set([]byte(conflict), record(bob, 7, "", "2stake"))
+	store.Set([]byte(plain), record(bob, 9, "", "5stake"))
+	store.Set([]byte("not-a-hash"), []byte(alice.String()))
+	store.Set([]byte(strings.Repeat("01", 32)), []byte("garbage"))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(f.ctx))
+
+	// nothing is left at the store root
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		require.Contains(t, []byte{0x01, 0x02, 0x03, 0x04, 0x07, 0x08}, iter.Key()[0], "unexpected key %X", iter.Key())
+	}
+	require.NoError(t, iter.Close())
+
+	got, found := f.keeper.GetFileRecord(f.ctx, merged)
+	require.True(t, found)
+	require.Equal(t, alice.String(), got.Creator)
+	require.Equal(t, alice.String(), got.Owner)
+	require.Equal(t, int64(0), got.BlockHeight)
+	require.Equal(t, "lab report", got.Label)
+	require.Equal(t, "10stake", got.Reward)
+
+	got, found = f.keeper.GetFileRecord(f.ctx, conflict)
+	require.True(t, found)
+	require.Equal(t, alice.String(), got.Creator)
+	require.Equal(t, "1stake", got.Reward)
+
+	got, found = f.keeper.GetFileRecord(f.ctx, plain)
+	require.True(t, found)
+	require.Equal(t, bob.String(), got.Creator)
+
+	var records int
+	f.keeper.IterateFileRecords(f.ctx, func(*types.FileRecord) bool {
+		records++
+		return false
+	})
+	require.Equal(t, 3, records)
+
+	require.True(t, store.Has(types.CreatorIndexKey(alice, merged)))
+	require.True(t, store.Has(types.CreatorIndexKey(alice, conflict)))
+	require.True(t, store.Has(types.CreatorIndexKey(bob, plain)))
+	require.False(t, store.Has(types.CreatorIndexKey(bob, conflict)))
+	require.True(t, store.Has(types.OwnerIndexKey(alice, merged)))
+	require.True(t, store.Has(types.OwnerIndexKey(bob, plain)))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 16)), f.keeper.GetTotalRewards(f.ctx))
+	require.Equal(t, types.DefaultParams(), f.keeper.GetParams(f.ctx))
+
+	msg, broken := keeper.CreatorIndexInvariant(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.OwnerIndexInvariant(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+	msg, broken = keeper.TotalRewardsInvariant(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+
+	// merged, conflicting and the two invalid entries are kept aside unchanged
+	// and reported
+	bobConflict := record(bob, 7, "", "2stake")
+	kept := map[string][]byte{
+		merged:                   record(alice, 5, "lab report", "10stake"),
+		conflict:                 bobConflict,
+		"not-a-hash":             []byte(alice.String()),
+		strings.Repeat("01", 32): []byte("garbage"),
+	}
+	for key, value := range kept {
+		entry, found := f.keeper.GetLegacyEntry(f.ctx, []byte(key))
+		require.True(t, found, key)
+		require.Equal(t, value, entry.Value, key)
+		require.NotEmpty(t, entry.Reason, key)
+	}
+	entry, _ := f.keeper.GetLegacyEntry(f.ctx, []byte(conflict))
+	require.Contains(t, entry.Reason, alice.String())
+
+	res, err := f.keeper.LegacyEntries(sdk.WrapSDKContext(f.ctx), &types.QueryLegacyEntriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.LegacyEntries, len(kept))
+	require.NoError(t, types.ValidateGenesis(f.keeper.ExportGenesis(f.ctx)))
+
+	var setAside int
+	for _, ev := range f.ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeLegacyFileSetAside {
+			setAside++
+		}
+	}
+	require.Equal(t, len(kept), setAside)
+}
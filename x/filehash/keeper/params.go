@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"doctorium/x/filehash/types"
+)
+
+// GetParams returns the current filehash parameters.
+func (k Keeper) GetParams(ctx sdk.Context) *types.Params {
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return &params
+}
+
+// SetParams stores the filehash parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(params))
+	return nil
+}
+
+// IsBlockedAccount reports whether addr may not register files, either because
+// governance put it on the blocked accounts list or because it is a module
+// account that is not allowed to receive funds.
+func (k Keeper) IsBlockedAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if k.bankKeeper.BlockedAddr(addr) {
+		return true
+	}
+	for _, blocked := range k.GetParams(ctx).BlockedAccounts {
+		if blocked == addr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// GetAuthority returns the address allowed to update the module parameters.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// UpdateParams implements the Msg/UpdateParams method.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// incrementUploadCount bumps the number of uploads creator made in the current
+// block and returns the new count. The counter lives in the transient store
+// and is therefore reset at every commit.
+func (k Keeper) incrementUploadCount(ctx sdk.Context, creator sdk.AccAddress) uint64 {
+	store := ctx.TransientStore(k.tkey)
+	key := types.UploadCountKey(creator)
+
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		count = sdk.BigEndianToUint64(bz)
+	}
+	count++
+	store.Set(key, sdk.Uint64ToBigEndian(count))
+	return count
+}
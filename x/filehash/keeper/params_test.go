@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/types"
+)
+
+// The ante decorator is not the only way into the msg server, so the keeper
+// rejects blocked creators on its own.
+func TestBlockedAccountsRejectedByKeeper(t *testing.T) {
+	f := setupKeeper(t)
+	ctx := sdk.WrapSDKContext(f.ctx)
+
+	listed := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	bankBlocked := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	allowed := sdk.AccAddress(bytes.Repeat([]byte{3}, 20))
+	f.bank.blocked[bankBlocked.String()] = true
+
+	params := types.DefaultParams()
+	params.BlockedAccounts = []string{listed.String()}
+	require.NoError(t, f.keeper.SetParams(f.ctx, params))
+
+	for _, addr := range []sdk.AccAddress{listed, bankBlocked} {
+		creator := addr.String()
+		hash := strings.Repeat(hex.EncodeToString(addr[:1]), 32)
+
+		_, err := f.keeper.UploadFile(ctx, &types.MsgUploadFile{
+			Creator: creator, FileHash: hash, HashAlgorithm: types.DefaultHashAlgorithm,
+		})
+		require.ErrorIs(t, err, types.ErrBlockedAccount)
+
+		_, err = f.keeper.UploadFiles(ctx, &types.MsgUploadFiles{
+			Creator: creator, Atomic: true,
+			Files: []*types.UploadFileItem{{FileHash: hash, HashAlgorithm: types.DefaultHashAlgorithm}},
+		})
+		require.ErrorIs(t, err, types.ErrBlockedAccount)
+
+		res, err := f.keeper.UploadFiles(ctx, &types.MsgUploadFiles{
+			Creator: creator,
+			Files:   []*types.UploadFileItem{{FileHash: hash, HashAlgorithm: types.DefaultHashAlgorithm}},
+		})
+		require.NoError(t, err)
+		require.Zero(t, res.Registered)
+		require.Contains(t, res.Results[0].Error, types.ErrBlockedAccount.Error())
+
+		_, err = f.keeper.AnchorMerkleRoot(ctx, &types.MsgAnchorMerkleRoot{
+			Creator: creator, Root: hash, LeafCount: 2, TreeAlgorithm: "rfc6962-sha256",
+		})
+		require.ErrorIs(t, err, types.ErrBlockedAccount)
+
+		require.False(t, f.keeper.HasFileHash(f.ctx, hash))
+		require.False(t, f.keeper.HasAnchoredRoot(f.ctx, hash))
+	}
+
+	hash := strings.Repeat("0f", 32)
+	_, err := f.keeper.UploadFile(ctx, &types.MsgUploadFile{
+		Creator: allowed.String(), FileHash: hash, HashAlgorithm: types.DefaultHashAlgorithm,
+	})
+	require.NoError(t, err)
+	require.True(t, f.keeper.HasFileHash(f.ctx, hash))
+	require.Equal(t, params.RewardCoins(), f.bank.GetAllBalances(f.ctx, allowed))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"doctorium/x/filehash/types"
+)
+
+// BindPatient implements the Msg/BindPatient method. Documents registered
+// without a patient, all records migrated from v1 included, cannot receive
+// consent until they are bound to one. The creator or the current owner of
+// the document binds it once; an existing binding is never replaced, since
+// consents already granted rest on it.
+func (k Keeper) BindPatient(goCtx context.Context, msg *types.MsgBindPatient) (*types.MsgBindPatientResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, found := k.GetFileRecord(ctx, strings.ToLower(msg.FileHash))
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrFileNotFound, msg.FileHash)
+	}
+	if msg.Creator != record.Creator && msg.Creator != record.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "file %s was registered by %s and belongs to %s",
+			record.FileHash, record.Creator, record.Owner)
+	}
+	if record.Patient != "" {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "file %s is already bound to %s", record.FileHash, record.Patient)
+	}
+
+	record.Patient = msg.Patient
+	k.SetFileRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePatientBound,
+		sdk.NewAttribute(types.AttributeKeyFileHash, record.FileHash),
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyPatient, msg.Patient),
+	))
+	return &types.MsgBindPatientResponse{}, nil
+}
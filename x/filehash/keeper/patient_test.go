@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/types"
+)
+
+func TestBindPatient(t *testing.T) {
+	f := setupKeeper(t)
+	ctx := sdk.WrapSDKContext(f.ctx)
+	alice := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+	bob := sdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String()
+	patient := sdk.AccAddress(bytes.Repeat([]byte{3}, 20)).String()
+	other := sdk.AccAddress(bytes.Repeat([]byte{4}, 20)).String()
+	var (
+		byCreator = strings.Repeat("1a", 32)
+		byOwner   = strings.Repeat("1b", 32)
+		preBound  = strings.Repeat("1c", 32)
+	)
+	for _, hash := range []string{byCreator, byOwner} {
+		_, err := f.keeper.UploadFile(ctx, &types.MsgUploadFile{
+			Creator: alice, FileHash: hash, HashAlgorithm: types.DefaultHashAlgorithm,
+		})
+		require.NoError(t, err)
+	}
+	_, err := f.keeper.UploadFile(ctx, &types.MsgUploadFile{
+		Creator: alice, FileHash: preBound, HashAlgorithm: types.DefaultHashAlgorithm, Patient: patient,
+	})
+	require.NoError(t, err)
+	_, err = f.keeper.TransferFile(ctx, &types.MsgTransferFile{Creator: alice, FileHash: byOwner, NewOwner: bob})
+	require.NoError(t, err)
+
+	bind := func(signer, hash, patient string) error {
+		_, err := f.keeper.BindPatient(ctx, &types.MsgBindPatient{Creator: signer, FileHash: hash, Patient: patient})
+		return err
+	}
+
+	// 등록자도 소유자도 아닌 계정은 환자를 지정할 수 없다
+	require.ErrorIs(t, bind(other, byCreator, patient), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, bind(patient, byCreator, patient), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, bind(alice, strings.Repeat("1d", 32), patient), types.ErrFileNotFound)
+
+	require.NoError(t, bind(alice, strings.ToUpper(byCreator), patient))
+	require.NoError(t, bind(bob, byOwner, patient))
+	for _, hash := range []string{byCreator, byOwner} {
+		record, found := f.keeper.GetFileRecord(f.ctx, hash)
+		require.True(t, found)
+		require.Equal(t, patient, record.Patient)
+	}
+
+	// 한 번 지정된 환자는 바꿀 수 없다
+	require.ErrorIs(t, bind(alice, byCreator, other), sdkerrors.ErrInvalidRequest)
+	require.ErrorIs(t, bind(alice, preBound, other), sdkerrors.ErrInvalidRequest)
+	record, _ := f.keeper.GetFileRecord(f.ctx, preBound)
+	require.Equal(t, patient, record.Patient)
+}
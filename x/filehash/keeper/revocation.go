@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"doctorium/x/filehash/types"
+)
+
+// RevokeFile implements the Msg/RevokeFile method. Active and superseded
+// records can be revoked by their creator, also once they have been
+// transferred; revocation is final.
+func (k Keeper) RevokeFile(goCtx context.Context, msg *types.MsgRevokeFile) (*types.MsgRevokeFileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	record, err := k.getCreatedRecord(ctx, msg.FileHash, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if record.Status == types.FileStatus_FILE_STATUS_REVOKED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidStatus, "file %s is already revoked", record.FileHash)
+	}
+
+	record.Status = types.FileStatus_FILE_STATUS_REVOKED
+	record.RevocationReason = msg.Reason
+	record.RevocationNote = msg.Note
+	record.StatusHeight = ctx.BlockHeight()
+	k.SetFileRecord(ctx, record)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFileRevoked,
+		sdk.NewAttribute(types.AttributeKeyFileHash, record.FileHash),
+		sdk.NewAttribute(types.AttributeKeyCreator, record.Creator),
+		sdk.NewAttribute(types.AttributeKeyReason, msg.Reason.String()),
+	))
+	return &types.MsgRevokeFileResponse{}, nil
+}
+
+// SupersedeFile implements the Msg/SupersedeFile method. Both documents must
+// be active and registered by the signer, and the replacement may not already
+// replace another document, so version chains stay linear.
+func (k Keeper) SupersedeFile(goCtx context.Context, msg *types.MsgSupersedeFile) (*types.MsgSupersedeFileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	oldRecord, err := k.getCreatedRecord(ctx, msg.OldFileHash, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	newRecord, err := k.getCreatedRecord(ctx, msg.NewFileHash, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range []*types.FileRecord{oldRecord, newRecord} {
+		if r.Status != types.FileStatus_FILE_STATUS_ACTIVE {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidStatus, "file %s is %s", r.FileHash, r.Status)
+		}
+	}
+	if newRecord.Supersedes != "" {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidStatus, "file %s already supersedes %s", newRecord.FileHash, newRecord.Supersedes)
+	}
+
+	oldRecord.Status = types.FileStatus_FILE_STATUS_SUPERSEDED
+	oldRecord.SupersededBy = newRecord.FileHash
+	oldRecord.StatusHeight = ctx.BlockHeight()
+	newRecord.Supersedes = oldRecord.FileHash
+	k.SetFileRecord(ctx, oldRecord)
+	k.SetFileRecord(ctx, newRecord)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFileSuperseded,
+		sdk.NewAttribute(types.AttributeKeyFileHash, oldRecord.FileHash),
+		sdk.NewAttribute(types.AttributeKeySupersededBy, newRecord.FileHash),
+		sdk.NewAttribute(types.AttributeKeyCreator, oldRecord.Creator),
+	))
+	return &types.MsgSupersedeFileResponse{}, nil
+}
+
+// GetVersionChain returns every version linked to record through
+// supersession, oldest first.
+func (k Keeper) GetVersionChain(ctx sdk.Context, record *types.FileRecord) []*types.FileRecord {
+	seen := map[string]bool{record.FileHash: true}
+
+	// walk back to the first version
+	first := record
+	for first.Supersedes != "" && !seen[first.Supersedes] {
+		prev, found := k.GetFileRecord(ctx, first.Supersedes)
+		if !found {
+			break
+		}
+		seen[prev.FileHash] = true
+		first = prev
+	}
+
+	// then forward to the latest one
+	chain := []*types.FileRecord{first}
+	inChain := map[string]bool{first.FileHash: true}
+	for cur := first; cur.SupersededBy != "" && !inChain[cur.SupersededBy]; {
+		next, found := k.GetFileRecord(ctx, cur.SupersededBy)
+		if !found || next.Supersedes != cur.FileHash {
+			break
+		}
+		inChain[next.FileHash] = true
+		chain = append(chain, next)
+		cur = next
+	}
+	return chain
+}
+
+// getCreatedRecord loads the record of hash and checks that it was registered
+// by creator. Revocation and supersession are decided by the registering
+// provider, whoever owns the document now.
+func (k Keeper) getCreatedRecord(ctx sdk.Context, hash, creator string) (*types.FileRecord, error) {
+	record, found := k.GetFileRecord(ctx, strings.ToLower(hash))
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrFileNotFound, hash)
+	}
+	if record.Creator != creator {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "file %s was registered by %s", record.FileHash, record.Creator)
+	}
+	return record, nil
+}
+
+// getOwnedRecord loads the record of hash and checks that it currently
+// belongs to owner.
+func (k Keeper) getOwnedRecord(ctx sdk.Context, hash, owner string) (*types.FileRecord, error) {
+	record, found := k.GetFileRecord(ctx, strings.ToLower(hash))
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrFileNotFound, hash)
+	}
+	if record.Owner != owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "file %s belongs to %s", record.FileHash, record.Owner)
+	}
+	return record, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"doctorium/x/filehash/types"
+)
+
+// GetTotalRewards returns the sum of the rewards minted for every registered
+// file.
+func (k Keeper) GetTotalRewards(ctx sdk.Context) sdk.Coins {
+	bz := ctx.KVStore(k.storeKey).Get(types.TotalRewardsKey)
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+	coins, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return coins
+}
+
+// setTotalRewards stores the sum of the rewards minted so far.
+func (k Keeper) setTotalRewards(ctx sdk.Context, coins sdk.Coins) {
+	ctx.KVStore(k.storeKey).Set(types.TotalRewardsKey, []byte(coins.String()))
+}
+
+// addTotalRewards adds newly minted rewards to the running total.
+func (k Keeper) addTotalRewards(ctx sdk.Context, coins sdk.Coins) {
+	k.setTotalRewards(ctx, k.GetTotalRewards(ctx).Add(coins...))
+}
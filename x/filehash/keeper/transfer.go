@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"doctorium/x/filehash/types"
+)
+
+// TransferFile implements the Msg/TransferFile method.
+func (k Keeper) TransferFile(goCtx context.Context, msg *types.MsgTransferFile) (*types.MsgTransferFileResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.transferFile(ctx, msg.FileHash, msg.Creator, msg.NewOwner); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferFileResponse{}, nil
+}
+
+// TransferFiles implements the Msg/TransferFiles method. A failing transfer
+// aborts the transaction, so either every document moves or none does.
+func (k Keeper) TransferFiles(goCtx context.Context, msg *types.MsgTransferFiles) (*types.MsgTransferFilesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, hash := range msg.FileHashes {
+		if err := k.transferFile(ctx, hash, msg.Creator, msg.NewOwner); err != nil {
+			return nil, err
+		}
+	}
+	return &types.MsgTransferFilesResponse{}, nil
+}
+
+// transferFile moves the record of hash from owner to newOwner, re-indexes it
+// under the new owner and appends the transfer to its ownership history. The
+// creator of the record stays the account that registered it.
+func (k Keeper) transferFile(ctx sdk.Context, hash, owner, newOwner string) error {
+	record, err := k.getOwnedRecord(ctx, hash, owner)
+	if err != nil {
+		return err
+	}
+
+	newOwnerAddr, err := sdk.AccAddressFromBech32(newOwner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if k.IsBlockedAccount(ctx, newOwnerAddr) {
+		return sdkerrors.Wrapf(types.ErrBlockedAccount, "%s", newOwner)
+	}
+
+	// 이전 소유자의 인덱스를 지우고 새 소유자 아래에 다시 인덱싱한다
+	ownerAddr := sdk.MustAccAddressFromBech32(record.Owner)
+	ctx.KVStore(k.storeKey).Delete(types.OwnerIndexKey(ownerAddr, record.FileHash))
+	record.Owner = newOwner
+	k.SetFileRecord(ctx, record)
+
+	transfer := &types.OwnershipTransfer{
+		From:        owner,
+		To:          newOwner,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime(),
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		transfer.TxHash = fmt.Sprintf("%X", tmhash.Sum(txBytes))
+	}
+	history := k.GetOwnershipHistory(ctx, record.FileHash)
+	history.Transfers = append(history.Transfers, transfer)
+	k.SetOwnershipHistory(ctx, history)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFileTransferred,
+		sdk.NewAttribute(types.AttributeKeyFileHash, record.FileHash),
+		sdk.NewAttribute(types.AttributeKeyPreviousOwner, owner),
+		sdk.NewAttribute(types.AttributeKeyNewOwner, newOwner),
+	))
+	return nil
+}
+
+// GetOwnershipHistory returns the ownership transfers of hash. A document that
+// never changed hands has an empty history.
+func (k Keeper) GetOwnershipHistory(ctx sdk.Context, hash string) *types.OwnershipHistory {
+	bz := ctx.KVStore(k.storeKey).Get(types.OwnershipHistoryKey(hash))
+	if bz == nil {
+		return &types.OwnershipHistory{FileHash: hash}
+	}
+	var history types.OwnershipHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return &history
+}
+
+// SetOwnershipHistory stores the ownership history of a document.
+func (k Keeper) SetOwnershipHistory(ctx sdk.Context, history *types.OwnershipHistory) {
+	ctx.KVStore(k.storeKey).Set(types.OwnershipHistoryKey(history.FileHash), k.cdc.MustMarshal(history))
+}
+
+// IterateOwnershipHistories calls cb for every stored ownership history in
+// hash order until cb returns true.
+func (k Keeper) IterateOwnershipHistories(ctx sdk.Context, cb func(history *types.OwnershipHistory) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.OwnershipPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var history types.OwnershipHistory
+		k.cdc.MustUnmarshal(iter.Value(), &history)
+		if cb(&history) {
+			break
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/keeper"
+	"doctorium/x/filehash/types"
+)
+
+func TestTransferKeepsCreator(t *testing.T) {
+	f := setupKeeper(t)
+	ctx := sdk.WrapSDKContext(f.ctx)
+	alice := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	bob := sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	patient := sdk.AccAddress(bytes.Repeat([]byte{3}, 20))
+	hash := strings.Repeat("0d", 32)
+
+	_, err := f.keeper.UploadFile(ctx, &types.MsgUploadFile{
+		Creator: alice.String(), FileHash: hash, HashAlgorithm: types.DefaultHashAlgorithm, Patient: patient.String(),
+	})
+	require.NoError(t, err)
+	_, err = f.keeper.TransferFile(ctx, &types.MsgTransferFile{
+		Creator: alice.String(), FileHash: hash, NewOwner: bob.String(),
+	})
+	require.NoError(t, err)
+
+	record, found := f.keeper.GetFileRecord(f.ctx, hash)
+	require.True(t, found)
+	require.Equal(t, alice.String(), record.Creator)
+	require.Equal(t, bob.String(), record.Owner)
+	require.Equal(t, patient.String(), record.Patient)
+
+	byCreator, err := f.keeper.FilesByCreator(ctx, &types.QueryFilesByCreatorRequest{Creator: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, byCreator.Files, 1)
+	byOwner, err := f.keeper.FilesByOwner(ctx, &types.QueryFilesByOwnerRequest{Owner: alice.String()})
+	require.NoError(t, err)
+	require.Empty(t, byOwner.Files)
+	byOwner, err = f.keeper.FilesByOwner(ctx, &types.QueryFilesByOwnerRequest{Owner: bob.String()})
+	require.NoError(t, err)
+	require.Len(t, byOwner.Files, 1)
+	require.Equal(t, hash, byOwner.Files[0].FileHash)
+
+	// 등록자라도 이전한 뒤에는 소유자 권한이 없다
+	_, err = f.keeper.TransferFile(ctx, &types.MsgTransferFile{
+		Creator: alice.String(), FileHash: hash, NewOwner: alice.String(),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// 폐기는 소유자가 아니라 등록자가 한다
+	_, err = f.keeper.RevokeFile(ctx, &types.MsgRevokeFile{
+		Creator: bob.String(), FileHash: hash, Reason: types.RevocationReason_REVOCATION_REASON_ISSUED_IN_ERROR,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = f.keeper.RevokeFile(ctx, &types.MsgRevokeFile{
+		Creator: alice.String(), FileHash: hash, Reason: types.RevocationReason_REVOCATION_REASON_ISSUED_IN_ERROR,
+	})
+	require.NoError(t, err)
+
+	history := f.keeper.GetOwnershipHistory(f.ctx, hash)
+	require.Len(t, history.Transfers, 1)
+	require.Equal(t, bob.String(), history.Transfers[0].To)
+
+	msg, broken := keeper.AllInvariants(f.keeper)(f.ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,174 @@
+// Package merkle builds merkle trees over document digests and verifies
+// inclusion proofs against roots anchored with MsgAnchorMerkleRoot.
+//
+// Trees follow the RFC 6962 layout: leaves are hashed as H(0x00 || leaf),
+// inner nodes as H(0x01 || left || right), and a range of n > 1 leaves is
+// split after the largest power of two smaller than n. The hash function H is
+// selected by the tree algorithm.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"math/bits"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/sha3"
+)
+
+// Supported tree algorithms.
+const (
+	AlgorithmSHA256     = "rfc6962-sha256"
+	AlgorithmSHA3_256   = "rfc6962-sha3-256"
+	AlgorithmBlake2b256 = "rfc6962-blake2b-256"
+
+	DefaultAlgorithm = AlgorithmSHA256
+)
+
+// RootSize is the size in bytes of the root produced by every supported
+// algorithm.
+const RootSize = 32
+
+var (
+	leafPrefix  = []byte{0x00}
+	innerPrefix = []byte{0x01}
+)
+
+// ErrUnsupportedAlgorithm is returned for unknown tree algorithms.
+var ErrUnsupportedAlgorithm = errors.New("unsupported merkle tree algorithm")
+
+// IsSupported reports whether algorithm is a known tree algorithm.
+func IsSupported(algorithm string) bool {
+	_, err := newHash(algorithm)
+	return err == nil
+}
+
+func newHash(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case AlgorithmSHA256:
+		return sha256.New, nil
+	case AlgorithmSHA3_256:
+		return sha3.New256, nil
+	case AlgorithmBlake2b256:
+		return func() hash.Hash {
+			h, _ := blake2b.New256(nil)
+			return h
+		}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
+
+// Tree is a merkle tree over an ordered list of leaves.
+type Tree struct {
+	algorithm  string
+	newHash    func() hash.Hash
+	leafHashes [][]byte
+	root       []byte
+}
+
+// New builds the tree of leaves with the given algorithm. Leaves are usually
+// the raw digests of the documents, in the order they were exported.
+func New(algorithm string, leaves [][]byte) (*Tree, error) {
+	h, err := newHash(algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if len(leaves) == 0 {
+		return nil, errors.New("merkle tree needs at least one leaf")
+	}
+
+	t := &Tree{algorithm: algorithm, newHash: h, leafHashes: make([][]byte, len(leaves))}
+	for i, leaf := range leaves {
+		t.leafHashes[i] = t.hashLeaf(leaf)
+	}
+	t.root = t.subtreeRoot(0, len(leaves))
+	return t, nil
+}
+
+// Algorithm returns the tree algorithm.
+func (t *Tree) Algorithm() string { return t.algorithm }
+
+// LeafCount returns the number of leaves of the tree.
+func (t *Tree) LeafCount() uint64 { return uint64(len(t.leafHashes)) }
+
+// Root returns the root hash of the tree.
+func (t *Tree) Root() []byte { return t.root }
+
+// Prove returns the inclusion proof of the leaf at index.
+func (t *Tree) Prove(index uint64, leaf []byte) (*Proof, error) {
+	if index >= t.LeafCount() {
+		return nil, fmt.Errorf("leaf index %d out of range, tree has %d leaves", index, t.LeafCount())
+	}
+	if !bytes.Equal(t.hashLeaf(leaf), t.leafHashes[index]) {
+		return nil, fmt.Errorf("leaf %X is not at index %d", leaf, index)
+	}
+
+	aunts := t.aunts(0, len(t.leafHashes), int(index))
+	proof := &Proof{
+		Algorithm: t.algorithm,
+		Root:      hex.EncodeToString(t.root),
+		LeafCount: t.LeafCount(),
+		Index:     index,
+		Leaf:      hex.EncodeToString(leaf),
+		Aunts:     make([]string, len(aunts)),
+	}
+	for i, aunt := range aunts {
+		proof.Aunts[i] = hex.EncodeToString(aunt)
+	}
+	return proof, nil
+}
+
+// aunts returns the sibling hashes on the path from the leaf at index up to
+// the root of the range [lo, hi), lowest first.
+func (t *Tree) aunts(lo, hi, index int) [][]byte {
+	if hi-lo == 1 {
+		return nil
+	}
+	k := lo + splitPoint(hi-lo)
+	if index < k {
+		return append(t.aunts(lo, k, index), t.subtreeRoot(k, hi))
+	}
+	return append(t.aunts(k, hi, index), t.subtreeRoot(lo, k))
+}
+
+// subtreeRoot returns the root of the leaves in [lo, hi).
+func (t *Tree) subtreeRoot(lo, hi int) []byte {
+	if hi-lo == 1 {
+		return t.leafHashes[lo]
+	}
+	k := lo + splitPoint(hi-lo)
+	return t.hashInner(t.subtreeRoot(lo, k), t.subtreeRoot(k, hi))
+}
+
+func (t *Tree) hashLeaf(leaf []byte) []byte {
+	return hashLeaf(t.newHash, leaf)
+}
+
+func (t *Tree) hashInner(left, right []byte) []byte {
+	return hashInner(t.newHash, left, right)
+}
+
+func hashLeaf(newHash func() hash.Hash, leaf []byte) []byte {
+	h := newHash()
+	h.Write(leafPrefix)
+	h.Write(leaf)
+	return h.Sum(nil)
+}
+
+func hashInner(newHash func() hash.Hash, left, right []byte) []byte {
+	h := newHash()
+	h.Write(innerPrefix)
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// splitPoint returns the largest power of two smaller than n, for n > 1.
+func splitPoint(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
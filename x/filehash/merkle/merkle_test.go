@@ -0,0 +1,169 @@
+package merkle_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	cmtmerkle "github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/merkle"
+)
+
+// 1, 2, 3 and 2^k, 2^k+1 leaves cover every way a range is split
+var leafCounts = []int{1, 2, 3, 4, 5, 8, 9, 16, 17}
+
+var algorithms = []string{merkle.AlgorithmSHA256, merkle.AlgorithmSHA3_256, merkle.AlgorithmBlake2b256}
+
+func makeLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		sum := sha256.Sum256([]byte(fmt.Sprintf("document %d", i)))
+		leaves[i] = sum[:]
+	}
+	return leaves
+}
+
+func TestNew(t *testing.T) {
+	_, err := merkle.New(merkle.DefaultAlgorithm, nil)
+	require.Error(t, err)
+	_, err = merkle.New("sha1", makeLeaves(1))
+	require.ErrorIs(t, err, merkle.ErrUnsupportedAlgorithm)
+
+	for _, n := range leafCounts {
+		leaves := makeLeaves(n)
+		tree, err := merkle.New(merkle.AlgorithmSHA256, leaves)
+		require.NoError(t, err)
+		require.Equal(t, uint64(n), tree.LeafCount())
+		require.Len(t, tree.Root(), merkle.RootSize)
+		// CometBFT hashes its merkle trees with the same RFC 6962 layout
+		require.Equal(t, cmtmerkle.HashFromByteSlices(leaves), tree.Root(), "%d leaves", n)
+	}
+}
+
+func TestProveVerify(t *testing.T) {
+	for _, algorithm := range algorithms {
+		for _, n := range leafCounts {
+			leaves := makeLeaves(n)
+			tree, err := merkle.New(algorithm, leaves)
+			require.NoError(t, err)
+
+			for i, leaf := range leaves {
+				name := fmt.Sprintf("%s/%d leaves/index %d", algorithm, n, i)
+				proof, err := tree.Prove(uint64(i), leaf)
+				require.NoError(t, err, name)
+				require.Equal(t, hex.EncodeToString(tree.Root()), proof.Root, name)
+				require.NoError(t, proof.Verify(tree.Root()), name)
+
+				// the proof survives the JSON written by the prove command
+				bz, err := json.Marshal(proof)
+				require.NoError(t, err)
+				var decoded merkle.Proof
+				require.NoError(t, json.Unmarshal(bz, &decoded))
+				require.NoError(t, decoded.Verify(tree.Root()), name)
+			}
+		}
+	}
+}
+
+func TestProveRejectsWrongLeaf(t *testing.T) {
+	leaves := makeLeaves(5)
+	tree, err := merkle.New(merkle.DefaultAlgorithm, leaves)
+	require.NoError(t, err)
+
+	_, err = tree.Prove(5, leaves[0])
+	require.Error(t, err)
+	_, err = tree.Prove(1, leaves[0])
+	require.Error(t, err)
+}
+
+func TestVerifyRejectsTamperedProof(t *testing.T) {
+	for _, n := range leafCounts {
+		leaves := makeLeaves(n)
+		tree, err := merkle.New(merkle.DefaultAlgorithm, leaves)
+		require.NoError(t, err)
+		root := tree.Root()
+
+		for i, leaf := range leaves {
+			name := fmt.Sprintf("%d leaves/index %d", n, i)
+			prove := func() *merkle.Proof {
+				proof, err := tree.Prove(uint64(i), leaf)
+				require.NoError(t, err, name)
+				return proof
+			}
+
+			// a flipped bit in any aunt
+			for j := range prove().Aunts {
+				proof := prove()
+				proof.Aunts[j] = flipFirstBit(proof.Aunts[j])
+				require.Error(t, proof.Verify(root), "%s/aunt %d", name, j)
+			}
+
+			// a tampered leaf
+			proof := prove()
+			proof.Leaf = flipFirstBit(proof.Leaf)
+			require.Error(t, proof.Verify(root), name)
+
+			// a missing or an extra aunt
+			proof = prove()
+			proof.Aunts = append(proof.Aunts, hex.EncodeToString(root))
+			require.Error(t, proof.Verify(root), name)
+			if n > 1 {
+				proof = prove()
+				proof.Aunts = proof.Aunts[:len(proof.Aunts)-1]
+				require.Error(t, proof.Verify(root), name)
+			}
+
+			// another index
+			if n > 1 {
+				proof = prove()
+				proof.Index = uint64((i + 1) % n)
+				require.Error(t, proof.Verify(root), name)
+			}
+
+			// an index past the leaf count
+			proof = prove()
+			proof.Index = uint64(n)
+			require.Error(t, proof.Verify(root), name)
+			proof = prove()
+			proof.LeafCount = uint64(i)
+			require.Error(t, proof.Verify(root), name)
+
+			// a bad hex encoding
+			proof = prove()
+			proof.Leaf = "zz"
+			require.Error(t, proof.Verify(root), name)
+		}
+
+		// the last leaf moves to another subtree once a leaf is added
+		proof, err := tree.Prove(uint64(n-1), leaves[n-1])
+		require.NoError(t, err)
+		proof.LeafCount = uint64(n + 1)
+		require.Error(t, proof.Verify(root), "%d leaves", n)
+
+		// a proof checked against another tree's root
+		other, err := merkle.New(merkle.DefaultAlgorithm, makeLeaves(n+1))
+		require.NoError(t, err)
+		proof, err = tree.Prove(0, leaves[0])
+		require.NoError(t, err)
+		require.Error(t, proof.Verify(other.Root()), "%d leaves", n)
+
+		// and with another algorithm
+		proof.Algorithm = merkle.AlgorithmSHA3_256
+		require.Error(t, proof.Verify(root), "%d leaves", n)
+		proof.Algorithm = "sha1"
+		require.ErrorIs(t, proof.Verify(root), merkle.ErrUnsupportedAlgorithm)
+	}
+}
+
+func flipFirstBit(s string) string {
+	bz, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	bz[0] ^= 0x80
+	return hex.EncodeToString(bz)
+}
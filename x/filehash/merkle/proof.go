@@ -0,0 +1,87 @@
+package merkle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+)
+
+// Proof is the inclusion proof of one leaf. It is serialized as JSON by the
+// prove command and read back by verify-inclusion; all byte fields are hex
+// encoded.
+type Proof struct {
+	Algorithm string `json:"algorithm"`
+	// root of the tree the proof was generated from
+	Root      string `json:"root"`
+	LeafCount uint64 `json:"leaf_count"`
+	Index     uint64 `json:"index"`
+	// the leaf itself, usually the digest of the document
+	Leaf string `json:"leaf"`
+	// sibling hashes from the leaf up to the root, lowest first
+	Aunts []string `json:"aunts"`
+}
+
+// ComputeRoot recomputes the root the proof leads to.
+func (p *Proof) ComputeRoot() ([]byte, error) {
+	newHash, err := newHash(p.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if p.Index >= p.LeafCount {
+		return nil, fmt.Errorf("leaf index %d out of range, tree has %d leaves", p.Index, p.LeafCount)
+	}
+	leaf, err := hex.DecodeString(p.Leaf)
+	if err != nil {
+		return nil, fmt.Errorf("leaf is not hex encoded: %w", err)
+	}
+	aunts := make([][]byte, len(p.Aunts))
+	for i, aunt := range p.Aunts {
+		if aunts[i], err = hex.DecodeString(aunt); err != nil {
+			return nil, fmt.Errorf("aunt %d is not hex encoded: %w", i, err)
+		}
+	}
+	return computeRoot(newHash, p.Index, p.LeafCount, hashLeaf(newHash, leaf), aunts)
+}
+
+// Verify checks that the proof leads to root.
+func (p *Proof) Verify(root []byte) error {
+	computed, err := p.ComputeRoot()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(computed, root) {
+		return fmt.Errorf("proof leads to root %X, expected %X", computed, root)
+	}
+	return nil
+}
+
+// computeRoot folds aunts into leafHash, mirroring the way Tree splits its
+// leaves. Every aunt must be consumed exactly.
+func computeRoot(newHash func() hash.Hash, index, total uint64, leafHash []byte, aunts [][]byte) ([]byte, error) {
+	if total == 1 {
+		if len(aunts) != 0 {
+			return nil, errors.New("proof has more aunts than the tree is deep")
+		}
+		return leafHash, nil
+	}
+	if len(aunts) == 0 {
+		return nil, errors.New("proof has fewer aunts than the tree is deep")
+	}
+
+	last := aunts[len(aunts)-1]
+	k := uint64(splitPoint(int(total)))
+	if index < k {
+		left, err := computeRoot(newHash, index, k, leafHash, aunts[:len(aunts)-1])
+		if err != nil {
+			return nil, err
+		}
+		return hashInner(newHash, left, last), nil
+	}
+	right, err := computeRoot(newHash, index-k, total-k, leafHash, aunts[:len(aunts)-1])
+	if err != nil {
+		return nil, err
+	}
+	return hashInner(newHash, last, right), nil
+}
@@ -0,0 +1,217 @@
+package v2
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"doctorium/x/filehash/types"
+)
+
+// v2Prefixes are the first bytes of every key written by v2. v1 keys are the
+// raw hashes as submitted and never start with one of them.
+var v2Prefixes = [][]byte{
+	types.FileKeyPrefix,
+	types.CreatorIndexPrefix,
+	types.ParamsKey,
+	types.TotalRewardsKey,
+	types.OwnershipPrefix,
+	types.AnchoredRootPrefix,
+	types.OwnerIndexPrefix,
+	types.LegacyEntryPrefix,
+}
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+//
+// v1 wrote every file entry straight to the root of the module store, keyed
+// by the hash as submitted, with either the bare creator address or an encoded
+// FileRecord as value. v2 keeps records under types.FileKeyPrefix, keyed by
+// the lowercase hash, and indexes them by creator and by owner, so the
+// root-level entries are moved into the new layout. v1 had no transfers, so
+// every creator becomes the owner of its records. Params are introduced in v2
+// and initialised to their defaults, and the reward total is seeded from the
+// migrated records.
+//
+// v1 did not normalize hashes, so the same digest may have been registered
+// several times in different case. Such duplicates collapse into the earliest
+// registration; when they share the creator the later entries are merged into
+// it. Later registrations by another creator, entries that cannot be decoded
+// and entries whose key is not a valid digest cannot be migrated. None of
+// these entries is deleted: they are moved unchanged under
+// types.LegacyEntryPrefix together with the reason, merged entries included,
+// where they stay queryable through Query/LegacyEntries and are exported with
+// the genesis. Each of them is also reported with a legacy_file_set_aside
+// event carrying its hex encoded key and the reason.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	// 이터레이터가 열린 동안에는 쓰지 않도록 먼저 수집한다
+	var (
+		legacyKeys [][]byte
+		entries    = make(map[string]*legacyEntry)
+		hashes     []string
+		setAside   []*types.LegacyEntry
+	)
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if isV2Key(iter.Key()) {
+			continue
+		}
+		key := bytes.Clone(iter.Key())
+		legacyKeys = append(legacyKeys, key)
+
+		value := bytes.Clone(iter.Value())
+		record, err := legacyRecord(cdc, key, value)
+		if err != nil {
+			setAside = append(setAside, newLegacyEntry(key, value, err.Error()))
+			continue
+		}
+		entry := &legacyEntry{key: key, value: value, record: record}
+
+		kept, found := entries[record.FileHash]
+		if !found {
+			entries[record.FileHash] = entry
+			hashes = append(hashes, record.FileHash)
+			continue
+		}
+		// 대소문자만 다른 중복 등록: 먼저 등록된 쪽을 남긴다
+		if registeredBefore(entry.record, kept.record) {
+			entries[record.FileHash], kept, entry = entry, entry, kept
+		}
+		if entry.record.Creator != kept.record.Creator {
+			setAside = append(setAside, newLegacyEntry(entry.key, entry.value,
+				fmt.Sprintf("duplicate of %s registered first by %s", hex.EncodeToString(kept.key), kept.record.Creator)))
+			continue
+		}
+		mergeRecord(kept.record, entry.record)
+		setAside = append(setAside, newLegacyEntry(entry.key, entry.value,
+			fmt.Sprintf("merged into %s", hex.EncodeToString(kept.key))))
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range legacyKeys {
+		store.Delete(key)
+	}
+	for _, entry := range setAside {
+		store.Set(types.LegacyEntryKey(entry.Key), cdc.MustMarshal(entry))
+		reportSetAside(ctx, entry)
+	}
+
+	total := sdk.NewCoins()
+	if bz := store.Get(types.TotalRewardsKey); bz != nil {
+		var err error
+		if total, err = sdk.ParseCoinsNormalized(string(bz)); err != nil {
+			return fmt.Errorf("invalid total rewards: %w", err)
+		}
+	}
+	for _, hash := range hashes {
+		record := entries[hash].record
+		creator := sdk.MustAccAddressFromBech32(record.Creator)
+		store.Set(types.FileKey(record.FileHash), cdc.MustMarshal(record))
+		store.Set(types.CreatorIndexKey(creator, record.FileHash), []byte{})
+		store.Set(types.OwnerIndexKey(creator, record.FileHash), []byte{})
+
+		reward, _ := record.RewardCoins()
+		total = total.Add(reward...)
+	}
+	store.Set(types.TotalRewardsKey, []byte(total.String()))
+
+	if !store.Has(types.ParamsKey) {
+		store.Set(types.ParamsKey, cdc.MustMarshal(types.DefaultParams()))
+	}
+	return nil
+}
+
+// legacyEntry is a v1 root-level entry together with the record it decodes to.
+type legacyEntry struct {
+	key    []byte
+	value  []byte
+	record *types.FileRecord
+}
+
+func newLegacyEntry(key, value []byte, reason string) *types.LegacyEntry {
+	return &types.LegacyEntry{Key: key, Value: value, Reason: reason}
+}
+
+// legacyRecord decodes a v1 root-level entry into a valid v2 record keyed by
+// the normalized hash.
+func legacyRecord(cdc codec.BinaryCodec, key, value []byte) (*types.FileRecord, error) {
+	var record types.FileRecord
+	// 초기 버전은 hash -> creator 주소 문자열만 저장했다
+	if _, err := sdk.AccAddressFromBech32(string(value)); err == nil {
+		record.Creator = string(value)
+	} else if err := cdc.Unmarshal(value, &record); err != nil {
+		return nil, fmt.Errorf("decode v1 entry: %s", err)
+	}
+	if record.HashAlgorithm == "" {
+		record.HashAlgorithm = types.DefaultHashAlgorithm
+	}
+	// v1에는 소유권 이전이 없었으므로 등록자가 곧 소유자다
+	record.Owner = record.Creator
+	if record.FileHash != "" && !strings.EqualFold(record.FileHash, string(key)) {
+		return nil, fmt.Errorf("entry holds the record of %s", record.FileHash)
+	}
+
+	hash, err := types.NormalizeFileHash(record.HashAlgorithm, string(key))
+	if err != nil {
+		return nil, err
+	}
+	record.FileHash = hash
+	if err := record.Validate(); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// registeredBefore reports whether a was registered before b. Bare v1 entries
+// carry no height and predate every record that does.
+func registeredBefore(a, b *types.FileRecord) bool {
+	return a.BlockHeight < b.BlockHeight
+}
+
+// mergeRecord folds dup, a later registration of the same document by the
+// same creator, into kept: descriptive metadata missing from kept is taken
+// over and the rewards paid for both registrations add up.
+func mergeRecord(kept, dup *types.FileRecord) {
+	keptReward, _ := kept.RewardCoins()
+	dupReward, _ := dup.RewardCoins()
+	kept.Reward = keptReward.Add(dupReward...).String()
+
+	if kept.Size_ == 0 {
+		kept.Size_ = dup.Size_
+	}
+	if kept.MimeType == "" {
+		kept.MimeType = dup.MimeType
+	}
+	if kept.Label == "" {
+		kept.Label = dup.Label
+	}
+}
+
+// reportSetAside emits the event recording that a v1 entry was kept under the
+// legacy prefix instead of being migrated on its own.
+func reportSetAside(ctx sdk.Context, entry *types.LegacyEntry) {
+	key := hex.EncodeToString(entry.Key)
+	ctx.Logger().Info("setting v1 file entry aside", "module", types.ModuleName, "key", key, "reason", entry.Reason)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeLegacyFileSetAside,
+		sdk.NewAttribute(types.AttributeKeyLegacyKey, key),
+		sdk.NewAttribute(types.AttributeKeyReason, entry.Reason),
+	))
+}
+
+func isV2Key(key []byte) bool {
+	for _, prefix := range v2Prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
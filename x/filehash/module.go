@@ -3,24 +3,29 @@ package filehash
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	runtime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/spf13/cobra"
 
-	abci "cosmossdk.io/api/tendermint/abci"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"doctorium/x/filehash/client/cli"
 	keeper "doctorium/x/filehash/keeper"
 	types "doctorium/x/filehash/types"
 )
 
 var (
-	_ module.AppModuleBasic = AppModuleBasic{}
-	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModule           = AppModule{}
+	_ module.HasGenesis          = AppModule{}
+	_ module.BeginBlockAppModule = AppModule{}
+	_ module.EndBlockAppModule   = AppModule{}
 
 	ModuleName   = types.ModuleName
 	RouterKey    = types.RouterKey
@@ -56,24 +61,23 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(
 
 // GetTxCmd returns the root tx command for the filehash module.
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd returns the root query command for the filehash module.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+	return cli.GetQueryCmd()
 }
 
 // DefaultGenesis returns initial genesis state as raw JSON for the filehash module.
 func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
-	gs := &types.GenesisState{Files: []*types.FileData{}}
-	return cdc.MustMarshalJSON(gs)
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
 // ValidateGenesis performs genesis state validation.
 func (AppModuleBasic) ValidateGenesis(
 	cdc codec.JSONCodec,
-	txConfig client.TxConfig,
+	_ client.TxEncodingConfig,
 	bz json.RawMessage,
 ) error {
 	var gs types.GenesisState
@@ -102,10 +106,18 @@ func (am AppModule) Name() string {
 	return ModuleName
 }
 
-// RegisterServices registers module services.
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterServices registers module services and store migrations.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // InitGenesis initializes the module's state from genesis.
@@ -115,7 +127,8 @@ func (am AppModule) InitGenesis(
 	data json.RawMessage,
 ) []abci.ValidatorUpdate {
 	var gs types.GenesisState
-	_ = cdc.UnmarshalJSON(data, &gs)
+	cdc.MustUnmarshalJSON(data, &gs)
+	am.keeper.InitGenesis(ctx, &gs)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -124,12 +137,13 @@ func (am AppModule) ExportGenesis(
 	ctx sdk.Context,
 	cdc codec.JSONCodec,
 ) json.RawMessage {
-	gs := &types.GenesisState{Files: []*types.FileData{}}
-	return cdc.MustMarshalJSON(gs)
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
 }
 
 // RegisterInvariants registers module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // BeginBlock executes block begin logic.
 func (am AppModule) BeginBlock(
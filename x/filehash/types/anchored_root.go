@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"doctorium/x/filehash/merkle"
+)
+
+// NormalizeMerkleRoot checks that root is a hex encoded root produced by the
+// tree algorithm and returns its canonical lowercase form.
+func NormalizeMerkleRoot(algorithm, root string) (string, error) {
+	if !merkle.IsSupported(algorithm) {
+		return "", sdkerrors.Wrapf(ErrUnsupportedTreeAlgorithm, "%q", algorithm)
+	}
+	normalized := strings.ToLower(root)
+	bz, err := hex.DecodeString(normalized)
+	if err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidMerkleRoot, "%s is not hex encoded", root)
+	}
+	if len(bz) != merkle.RootSize {
+		return "", sdkerrors.Wrapf(ErrInvalidMerkleRoot, "root must be %d bytes, got %d", merkle.RootSize, len(bz))
+	}
+	return normalized, nil
+}
+
+// Validate performs stateless checks on a stored anchored root.
+func (r *AnchoredRoot) Validate() error {
+	if r == nil {
+		return errors.New("anchored root cannot be nil")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	normalized, err := NormalizeMerkleRoot(r.TreeAlgorithm, r.Root)
+	if err != nil {
+		return err
+	}
+	if normalized != r.Root {
+		return sdkerrors.Wrapf(ErrInvalidMerkleRoot, "%s is not in canonical lowercase form", r.Root)
+	}
+	if r.LeafCount == 0 {
+		return fmt.Errorf("root %s has no leaves", r.Root)
+	}
+	if r.BlockHeight < 0 {
+		return fmt.Errorf("negative block height %d", r.BlockHeight)
+	}
+	return nil
+}
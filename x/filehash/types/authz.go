@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &UploadFileAuthorization{}
+
+// isUploadMsgTypeURL reports whether an UploadFileAuthorization can cover the
+// message of type typeURL. The type URLs are only known once the proto types
+// are registered, so they cannot be collected in a package variable.
+func isUploadMsgTypeURL(typeURL string) bool {
+	switch typeURL {
+	case sdk.MsgTypeURL(&MsgUploadFile{}), sdk.MsgTypeURL(&MsgUploadFiles{}), sdk.MsgTypeURL(&MsgAnchorMerkleRoot{}):
+		return true
+	}
+	return false
+}
+
+// NewUploadFileAuthorization creates an authorization allowing the grantee to
+// register up to maxUploads documents on behalf of the granter with
+// MsgUploadFile.
+func NewUploadFileAuthorization(maxUploads uint64) *UploadFileAuthorization {
+	return &UploadFileAuthorization{RemainingUploads: maxUploads}
+}
+
+// NewUploadFileAuthorizationFor creates an authorization allowing the grantee
+// to register up to maxUploads documents with the message of type msgTypeURL,
+// one of MsgUploadFile, MsgUploadFiles and MsgAnchorMerkleRoot.
+func NewUploadFileAuthorizationFor(msgTypeURL string, maxUploads uint64) *UploadFileAuthorization {
+	return &UploadFileAuthorization{RemainingUploads: maxUploads, Msg: msgTypeURL}
+}
+
+// MsgTypeURL implements authz.Authorization.
+func (a *UploadFileAuthorization) MsgTypeURL() string {
+	if a.Msg == "" {
+		return sdk.MsgTypeURL(&MsgUploadFile{})
+	}
+	return a.Msg
+}
+
+// Accept implements authz.Authorization. Every registered document consumes
+// one of the remaining uploads, so a MsgUploadFiles consumes one per file and
+// an anchored merkle root one per leaf. The grant is deleted with the last
+// upload.
+func (a *UploadFileAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeURL() {
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+
+	var uploads uint64
+	switch msg := msg.(type) {
+	case *MsgUploadFile:
+		uploads = 1
+	case *MsgUploadFiles:
+		uploads = uint64(len(msg.Files))
+	case *MsgAnchorMerkleRoot:
+		uploads = msg.LeafCount
+	default:
+		return authz.AcceptResponse{}, sdkerrors.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if a.RemainingUploads < uploads {
+		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized,
+			"%d uploads requested, %d remaining", uploads, a.RemainingUploads)
+	}
+
+	remaining := a.RemainingUploads - uploads
+	if remaining == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	return authz.AcceptResponse{Accept: true, Updated: NewUploadFileAuthorizationFor(a.Msg, remaining)}, nil
+}
+
+// ValidateBasic implements authz.Authorization.
+func (a *UploadFileAuthorization) ValidateBasic() error {
+	if a.RemainingUploads == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "remaining uploads must be positive")
+	}
+	if a.Msg != "" && !isUploadMsgTypeURL(a.Msg) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "%s cannot be granted as upload", a.Msg)
+	}
+	return nil
+}
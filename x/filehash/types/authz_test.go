@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/types"
+)
+
+func TestUploadFileAuthorizationAccept(t *testing.T) {
+	var ctx sdk.Context
+	batch := &types.MsgUploadFiles{Files: make([]*types.UploadFileItem, 3)}
+
+	// 기본 권한은 단건 업로드만 허용한다
+	single := types.NewUploadFileAuthorization(2)
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgUploadFile{}), single.MsgTypeURL())
+	res, err := single.Accept(ctx, &types.MsgUploadFile{})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.Equal(t, uint64(1), res.Updated.(*types.UploadFileAuthorization).RemainingUploads)
+	_, err = single.Accept(ctx, batch)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	// a batch consumes one upload per file
+	batchGrant := types.NewUploadFileAuthorizationFor(sdk.MsgTypeURL(batch), 4)
+	require.NoError(t, batchGrant.ValidateBasic())
+	res, err = batchGrant.Accept(ctx, batch)
+	require.NoError(t, err)
+	updated := res.Updated.(*types.UploadFileAuthorization)
+	require.Equal(t, uint64(1), updated.RemainingUploads)
+	require.Equal(t, sdk.MsgTypeURL(batch), updated.MsgTypeURL())
+	_, err = updated.Accept(ctx, batch)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	res, err = types.NewUploadFileAuthorizationFor(sdk.MsgTypeURL(batch), 3).Accept(ctx, batch)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	// an anchored root consumes one upload per leaf
+	anchor := &types.MsgAnchorMerkleRoot{LeafCount: 100}
+	_, err = types.NewUploadFileAuthorizationFor(sdk.MsgTypeURL(anchor), 99).Accept(ctx, anchor)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	res, err = types.NewUploadFileAuthorizationFor(sdk.MsgTypeURL(anchor), 150).Accept(ctx, anchor)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), res.Updated.(*types.UploadFileAuthorization).RemainingUploads)
+	res, err = types.NewUploadFileAuthorizationFor(sdk.MsgTypeURL(anchor), 100).Accept(ctx, anchor)
+	require.NoError(t, err)
+	require.True(t, res.Delete)
+	_, err = types.NewUploadFileAuthorizationFor(sdk.MsgTypeURL(anchor), 1).Accept(ctx, &types.MsgUploadFile{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestUploadFileAuthorizationValidateBasic(t *testing.T) {
+	require.Error(t, types.NewUploadFileAuthorization(0).ValidateBasic())
+	require.NoError(t, types.NewUploadFileAuthorization(1).ValidateBasic())
+	require.Error(t, types.NewUploadFileAuthorizationFor(sdk.MsgTypeURL(&types.MsgRevokeFile{}), 1).ValidateBasic())
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // ModuleCdc is the global Legacy Amino codec.  Remove if you're not using Amino.
@@ -12,6 +14,17 @@ var ModuleCdc = codec.NewLegacyAmino()
 // RegisterLegacyAminoCodec registers concrete types on the Amino codec.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUploadFile{}, "doctorium/filehash/MsgUploadFile", nil)
+	cdc.RegisterConcrete(&MsgUploadFiles{}, "doctorium/filehash/MsgUploadFiles", nil)
+	cdc.RegisterConcrete(&MsgAnchorMerkleRoot{}, "doctorium/filehash/MsgAnchorMerkleRoot", nil)
+	cdc.RegisterConcrete(&MsgRevokeFile{}, "doctorium/filehash/MsgRevokeFile", nil)
+	cdc.RegisterConcrete(&MsgSupersedeFile{}, "doctorium/filehash/MsgSupersedeFile", nil)
+	cdc.RegisterConcrete(&MsgTransferFile{}, "doctorium/filehash/MsgTransferFile", nil)
+	cdc.RegisterConcrete(&MsgTransferFiles{}, "doctorium/filehash/MsgTransferFiles", nil)
+	cdc.RegisterConcrete(&MsgBindPatient{}, "doctorium/filehash/MsgBindPatient", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "doctorium/filehash/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgRequestAttestation{}, "doctorium/filehash/MsgRequestAttestation", nil)
+	cdc.RegisterConcrete(&MsgRelayFileRegistered{}, "doctorium/filehash/MsgRelayFileRegistered", nil)
+	cdc.RegisterConcrete(&UploadFileAuthorization{}, "doctorium/filehash/UploadFileAuthorization", nil)
 }
 
 // RegisterInterfaces registers module message and service interfaces
@@ -20,7 +33,21 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUploadFile{},
+		&MsgUploadFiles{},
+		&MsgAnchorMerkleRoot{},
+		&MsgRevokeFile{},
+		&MsgSupersedeFile{},
+		&MsgTransferFile{},
+		&MsgTransferFiles{},
+		&MsgBindPatient{},
+		&MsgUpdateParams{},
+		&MsgRequestAttestation{},
+		&MsgRelayFileRegistered{},
 	)
-	// 서비스 인터페이스도 등록하려면:
-	// types.RegisterMsgServer(registry, /* your server impl */)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&UploadFileAuthorization{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,66 @@
+package types_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"doctorium/x/filehash/types"
+)
+
+func TestRegisterInterfaces(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+
+	msgs := []sdk.Msg{
+		&types.MsgUploadFile{},
+		&types.MsgUploadFiles{},
+		&types.MsgAnchorMerkleRoot{},
+		&types.MsgRevokeFile{},
+		&types.MsgSupersedeFile{},
+		&types.MsgTransferFile{},
+		&types.MsgTransferFiles{},
+		&types.MsgBindPatient{},
+		&types.MsgUpdateParams{},
+		&types.MsgRequestAttestation{},
+		&types.MsgRelayFileRegistered{},
+	}
+	seen := make(map[string]struct{})
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		require.Regexp(t, `^/doctorium\.filehash\.Msg\w+$`, typeURL)
+		require.NotContains(t, seen, typeURL)
+		seen[typeURL] = struct{}{}
+
+		resolved, err := registry.Resolve(typeURL)
+		require.NoError(t, err)
+		require.IsType(t, msg, resolved)
+
+		any, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		var unpacked sdk.Msg
+		require.NoError(t, registry.UnpackAny(any, &unpacked))
+		require.IsType(t, msg, unpacked)
+	}
+
+	any, err := codectypes.NewAnyWithValue(types.NewUploadFileAuthorization(3))
+	require.NoError(t, err)
+	require.Equal(t, "/doctorium.filehash.UploadFileAuthorization", any.TypeUrl)
+	var authorization authz.Authorization
+	require.NoError(t, registry.UnpackAny(any, &authorization))
+	require.Equal(t, sdk.MsgTypeURL(&types.MsgUploadFile{}), authorization.MsgTypeURL())
+}
+
+func TestTypedEvent(t *testing.T) {
+	event, err := sdk.TypedEventToEvent(&types.EventFileRegistered{FileHash: "ab", Height: 3})
+	require.NoError(t, err)
+	require.Equal(t, "doctorium.filehash.EventFileRegistered", event.Type)
+
+	parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventFileRegistered{FileHash: "ab", Height: 3}, parsed)
+}
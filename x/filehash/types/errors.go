@@ -6,4 +6,17 @@ var (
 	ErrFileAlreadyExists = errors.Register(ModuleName, 1, "file already exists")
 	ErrInvalidAddress    = errors.Register(ModuleName, 2, "invalid address")
 	ErrEmptyHash         = errors.Register(ModuleName, 3, "empty file hash")
+	ErrInvalidHash       = errors.Register(ModuleName, 4, "invalid file hash")
+
+	ErrUnsupportedHashAlgorithm = errors.Register(ModuleName, 5, "unsupported hash algorithm")
+	ErrUploadsDisabled          = errors.Register(ModuleName, 6, "file uploads are disabled")
+	ErrUploadLimitExceeded      = errors.Register(ModuleName, 7, "upload limit per block exceeded")
+	ErrBlockedAccount           = errors.Register(ModuleName, 8, "account is blocked from uploading")
+	ErrInvalidVersion           = errors.Register(ModuleName, 9, "invalid filehash channel version")
+	ErrInvalidPacket            = errors.Register(ModuleName, 10, "invalid filehash packet")
+	ErrFileNotFound             = errors.Register(ModuleName, 11, "file not found")
+	ErrInvalidStatus            = errors.Register(ModuleName, 12, "invalid file status transition")
+	ErrUnsupportedTreeAlgorithm = errors.Register(ModuleName, 13, "unsupported merkle tree algorithm")
+	ErrInvalidMerkleRoot        = errors.Register(ModuleName, 14, "invalid merkle root")
+	ErrRootAlreadyAnchored      = errors.Register(ModuleName, 15, "merkle root already anchored")
 )
@@ -0,0 +1,40 @@
+package types
+
+// filehash module event types and attribute keys. Besides the typed
+// EventFileRegistered, every registration emits a plain file_registered event
+// so transactions can be searched with file_registered.file_hash='<hash>'.
+// Most remaining event types report IBC packet traffic on filehash channels;
+// legacy_file_set_aside reports v1 entries the v2 store migration kept under
+// the legacy prefix instead of migrating them.
+const (
+	EventTypeFileRegistered         = "file_registered"
+	EventTypeFileRevoked            = "file_revoked"
+	EventTypeFileSuperseded         = "file_superseded"
+	EventTypeFileTransferred        = "file_transferred"
+	EventTypePatientBound           = "patient_bound"
+	EventTypeRootAnchored           = "merkle_root_anchored"
+	EventTypeRecvAttestationRequest = "recv_attestation_request"
+	EventTypeRecvFileRegistered     = "recv_file_registered"
+	EventTypeFileAttestation        = "file_attestation"
+	EventTypeFileRelayAck           = "file_relay_ack"
+	EventTypeTimeout                = "filehash_timeout"
+	EventTypeLegacyFileSetAside     = "legacy_file_set_aside"
+
+	AttributeKeyFileHash      = "file_hash"
+	AttributeKeyCreator       = "creator"
+	AttributeKeyHashAlgorithm = "hash_algorithm"
+	AttributeKeyHeight        = "height"
+	AttributeKeyReward        = "reward"
+	AttributeKeyChannel       = "channel"
+	AttributeKeyRegistered    = "registered"
+	AttributeKeyAckError      = "error"
+	AttributeKeyReason        = "reason"
+	AttributeKeySupersededBy  = "superseded_by"
+	AttributeKeyPreviousOwner = "previous_owner"
+	AttributeKeyNewOwner      = "new_owner"
+	AttributeKeyPatient       = "patient"
+	AttributeKeyRoot          = "root"
+	AttributeKeyLeafCount     = "leaf_count"
+	AttributeKeyTreeAlgorithm = "tree_algorithm"
+	AttributeKeyLegacyKey     = "legacy_key"
+)
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxRevocationNoteLength is the maximum length in bytes of a revocation note.
+const MaxRevocationNoteLength = 256
+
+// Validate performs stateless checks on a stored file record.
+func (r *FileRecord) Validate() error {
+	if r == nil {
+		return errors.New("file record cannot be nil")
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if r.Patient != "" {
+		if _, err := sdk.AccAddressFromBech32(r.Patient); err != nil {
+			return fmt.Errorf("invalid patient address: %w", err)
+		}
+	}
+	normalized, err := NormalizeFileHash(r.HashAlgorithm, r.FileHash)
+	if err != nil {
+		return err
+	}
+	if normalized != r.FileHash {
+		return sdkerrors.Wrapf(ErrInvalidHash, "%s is not in canonical lowercase form", r.FileHash)
+	}
+	if r.BlockHeight < 0 {
+		return fmt.Errorf("negative block height %d", r.BlockHeight)
+	}
+	if _, err := r.RewardCoins(); err != nil {
+		return fmt.Errorf("invalid reward: %w", err)
+	}
+
+	switch r.Status {
+	case FileStatus_FILE_STATUS_ACTIVE:
+		if r.SupersededBy != "" {
+			return fmt.Errorf("active record %s cannot be superseded", r.FileHash)
+		}
+	case FileStatus_FILE_STATUS_REVOKED:
+		if r.RevocationReason == RevocationReason_REVOCATION_REASON_UNSPECIFIED {
+			return fmt.Errorf("revoked record %s has no revocation reason", r.FileHash)
+		}
+	case FileStatus_FILE_STATUS_SUPERSEDED:
+		if r.SupersededBy == "" {
+			return fmt.Errorf("superseded record %s does not name its successor", r.FileHash)
+		}
+	default:
+		return fmt.Errorf("unknown status %d of record %s", r.Status, r.FileHash)
+	}
+	if r.Supersedes == r.FileHash || r.SupersededBy == r.FileHash {
+		return fmt.Errorf("record %s cannot supersede itself", r.FileHash)
+	}
+	return nil
+}
+
+// RewardCoins returns the reward paid for the registration.
+func (r *FileRecord) RewardCoins() (sdk.Coins, error) {
+	return sdk.ParseCoinsNormalized(r.Reward)
+}
+
+// ParseRevocationReason parses a reason code given either as its full enum
+// name or in short form, e.g. "issued-in-error".
+func ParseRevocationReason(s string) (RevocationReason, error) {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "-", "_"))
+	if !strings.HasPrefix(name, "REVOCATION_REASON_") {
+		name = "REVOCATION_REASON_" + name
+	}
+	v, ok := RevocationReason_value[name]
+	if !ok || v == int32(RevocationReason_REVOCATION_REASON_UNSPECIFIED) {
+		return RevocationReason_REVOCATION_REASON_UNSPECIFIED, fmt.Errorf("unknown revocation reason %q", s)
+	}
+	return RevocationReason(v), nil
+}
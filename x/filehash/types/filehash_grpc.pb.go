@@ -1,231 +0,0 @@
-// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
-// versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             (unknown)
-// source: filehash.proto
-
-package types
-
-import (
-	context "context"
-	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
-)
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.64.0 or later.
-const _ = grpc.SupportPackageIsVersion9
-
-const (
-	Msg_UploadFile_FullMethodName = "/doctorium.filehash.Msg/UploadFile"
-)
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// Msg service for file upload and reward
-type MsgClient interface {
-	UploadFile(ctx context.Context, in *MsgUploadFile, opts ...grpc.CallOption) (*MsgUploadFileResponse, error)
-}
-
-type msgClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
-	return &msgClient{cc}
-}
-
-func (c *msgClient) UploadFile(ctx context.Context, in *MsgUploadFile, opts ...grpc.CallOption) (*MsgUploadFileResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(MsgUploadFileResponse)
-	err := c.cc.Invoke(ctx, Msg_UploadFile_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-// All implementations must embed UnimplementedMsgServer
-// for forward compatibility.
-//
-// Msg service for file upload and reward
-type MsgServer interface {
-	UploadFile(context.Context, *MsgUploadFile) (*MsgUploadFileResponse, error)
-	mustEmbedUnimplementedMsgServer()
-}
-
-// UnimplementedMsgServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMsgServer struct{}
-
-func (UnimplementedMsgServer) UploadFile(context.Context, *MsgUploadFile) (*MsgUploadFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadFile not implemented")
-}
-func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
-func (UnimplementedMsgServer) testEmbeddedByValue()             {}
-
-// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MsgServer will
-// result in compilation errors.
-type UnsafeMsgServer interface {
-	mustEmbedUnimplementedMsgServer()
-}
-
-func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
-	// If the following call pancis, it indicates UnimplementedMsgServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&Msg_ServiceDesc, srv)
-}
-
-func _Msg_UploadFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUploadFile)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UploadFile(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Msg_UploadFile_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UploadFile(ctx, req.(*MsgUploadFile))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Msg_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "doctorium.filehash.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UploadFile",
-			Handler:    _Msg_UploadFile_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "filehash.proto",
-}
-
-const (
-	Query_FileList_FullMethodName = "/doctorium.filehash.Query/FileList"
-)
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// Query service for checking file existence
-type QueryClient interface {
-	FileList(ctx context.Context, in *QueryFileListRequest, opts ...grpc.CallOption) (*QueryFileListResponse, error)
-}
-
-type queryClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
-	return &queryClient{cc}
-}
-
-func (c *queryClient) FileList(ctx context.Context, in *QueryFileListRequest, opts ...grpc.CallOption) (*QueryFileListResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(QueryFileListResponse)
-	err := c.cc.Invoke(ctx, Query_FileList_FullMethodName, in, out, cOpts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-// All implementations must embed UnimplementedQueryServer
-// for forward compatibility.
-//
-// Query service for checking file existence
-type QueryServer interface {
-	FileList(context.Context, *QueryFileListRequest) (*QueryFileListResponse, error)
-	mustEmbedUnimplementedQueryServer()
-}
-
-// UnimplementedQueryServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedQueryServer struct{}
-
-func (UnimplementedQueryServer) FileList(context.Context, *QueryFileListRequest) (*QueryFileListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FileList not implemented")
-}
-func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
-func (UnimplementedQueryServer) testEmbeddedByValue()               {}
-
-// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to QueryServer will
-// result in compilation errors.
-type UnsafeQueryServer interface {
-	mustEmbedUnimplementedQueryServer()
-}
-
-func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
-	// If the following call pancis, it indicates UnimplementedQueryServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
-	}
-	s.RegisterService(&Query_ServiceDesc, srv)
-}
-
-func _Query_FileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFileListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FileList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Query_FileList_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FileList(ctx, req.(*QueryFileListRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "doctorium.filehash.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FileList",
-			Handler:    _Query_FileList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "filehash.proto",
-}
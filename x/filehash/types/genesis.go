@@ -2,16 +2,90 @@ package types
 
 import "fmt"
 
-// ValidateGenesis checks that the genesis state is valid.
-// 여기서 필요한 검증 로직(중복 해시 없음 등)을 넣으시면 됩니다.
+// DefaultGenesis returns the default genesis state of the filehash module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Files:            []*FileRecord{},
+		Params:           DefaultParams(),
+		OwnershipHistory: []*OwnershipHistory{},
+		AnchoredRoots:    []*AnchoredRoot{},
+		LegacyEntries:    []*LegacyEntry{},
+	}
+}
+
+// ValidateGenesis checks that the genesis state is valid: every record must
+// be well formed, no hash may be registered twice, version links must resolve,
+// ownership histories must match the records, anchored roots and legacy
+// entries must be well formed and unique and the params must be valid.
 func ValidateGenesis(data *GenesisState) error {
-	// 예시: FileHash가 중복되지 않는지 확인
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
 	seen := make(map[string]struct{})
-	for _, f := range data.Files {
+	for i, f := range data.Files {
+		if err := f.Validate(); err != nil {
+			return fmt.Errorf("invalid file record at index %d: %w", i, err)
+		}
 		if _, exists := seen[f.FileHash]; exists {
 			return fmt.Errorf("duplicate file hash in genesis: %s", f.FileHash)
 		}
 		seen[f.FileHash] = struct{}{}
 	}
+
+	// supersession links must point at records of the same genesis
+	for _, f := range data.Files {
+		for _, linked := range []string{f.Supersedes, f.SupersededBy} {
+			if _, exists := seen[linked]; linked != "" && !exists {
+				return fmt.Errorf("file %s links to unknown version %s", f.FileHash, linked)
+			}
+		}
+	}
+
+	// ownership histories must belong to known files and end with the
+	// current owner
+	owners := make(map[string]string, len(data.Files))
+	for _, f := range data.Files {
+		owners[f.FileHash] = f.Owner
+	}
+	seenHistory := make(map[string]struct{})
+	for i, h := range data.OwnershipHistory {
+		owner, exists := owners[h.FileHash]
+		if !exists {
+			return fmt.Errorf("ownership history at index %d belongs to unknown file %s", i, h.FileHash)
+		}
+		if _, dup := seenHistory[h.FileHash]; dup {
+			return fmt.Errorf("duplicate ownership history for file %s", h.FileHash)
+		}
+		seenHistory[h.FileHash] = struct{}{}
+		if len(h.Transfers) == 0 {
+			return fmt.Errorf("empty ownership history for file %s", h.FileHash)
+		}
+		if last := h.Transfers[len(h.Transfers)-1]; last.To != owner {
+			return fmt.Errorf("ownership history of file %s ends with %s, but the file belongs to %s", h.FileHash, last.To, owner)
+		}
+	}
+
+	seenRoots := make(map[string]struct{})
+	for i, r := range data.AnchoredRoots {
+		if err := r.Validate(); err != nil {
+			return fmt.Errorf("invalid anchored root at index %d: %w", i, err)
+		}
+		if _, exists := seenRoots[r.Root]; exists {
+			return fmt.Errorf("duplicate anchored root in genesis: %s", r.Root)
+		}
+		seenRoots[r.Root] = struct{}{}
+	}
+
+	seenLegacy := make(map[string]struct{})
+	for i, e := range data.LegacyEntries {
+		if err := e.Validate(); err != nil {
+			return fmt.Errorf("invalid legacy entry at index %d: %w", i, err)
+		}
+		if _, exists := seenLegacy[string(e.Key)]; exists {
+			return fmt.Errorf("duplicate legacy entry in genesis: %X", e.Key)
+		}
+		seenLegacy[string(e.Key)] = struct{}{}
+	}
 	return nil
 }
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Supported digest algorithms for registered documents.
+const (
+	HashAlgorithmSHA256     = "sha256"
+	HashAlgorithmSHA3_256   = "sha3-256"
+	HashAlgorithmBlake2b256 = "blake2b-256"
+	HashAlgorithmMultihash  = "multihash"
+
+	DefaultHashAlgorithm = HashAlgorithmSHA256
+)
+
+// MaxMultihashDigestLength bounds the digest carried by a multihash.
+const MaxMultihashDigestLength = 64
+
+// digestLengths holds the digest size in bytes of the fixed-size algorithms.
+var digestLengths = map[string]int{
+	HashAlgorithmSHA256:     32,
+	HashAlgorithmSHA3_256:   32,
+	HashAlgorithmBlake2b256: 32,
+}
+
+// NormalizeFileHash checks that hash is a hex encoded digest produced by
+// algorithm and returns its canonical lowercase form. Two hashes of the same
+// document always normalize to the same string.
+func NormalizeFileHash(algorithm, hash string) (string, error) {
+	if hash == "" {
+		return "", ErrEmptyHash
+	}
+	normalized := strings.ToLower(hash)
+	bz, err := hex.DecodeString(normalized)
+	if err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidHash, "%s is not hex encoded", hash)
+	}
+
+	if algorithm == HashAlgorithmMultihash {
+		if err := validateMultihash(bz); err != nil {
+			return "", sdkerrors.Wrapf(ErrInvalidHash, "%s: %s", hash, err)
+		}
+		return normalized, nil
+	}
+
+	size, ok := digestLengths[algorithm]
+	if !ok {
+		return "", sdkerrors.Wrapf(ErrUnsupportedHashAlgorithm, "%q", algorithm)
+	}
+	if len(bz) != size {
+		return "", sdkerrors.Wrapf(ErrInvalidHash, "%s digest must be %d bytes, got %d", algorithm, size, len(bz))
+	}
+	return normalized, nil
+}
+
+// NormalizeRegisteredHash normalizes the hash of a document referred to by a
+// message that does not name its digest algorithm. Every fixed-size algorithm
+// produces digests of DefaultHashAlgorithm's size, so the hash is accepted
+// when it is valid for DefaultHashAlgorithm or is a well-formed multihash.
+func NormalizeRegisteredHash(hash string) (string, error) {
+	normalized, err := NormalizeFileHash(DefaultHashAlgorithm, hash)
+	if err == nil {
+		return normalized, nil
+	}
+	if normalized, mhErr := NormalizeFileHash(HashAlgorithmMultihash, hash); mhErr == nil {
+		return normalized, nil
+	}
+	return "", err
+}
+
+// validateMultihash checks the <varint code><varint length><digest> framing.
+func validateMultihash(bz []byte) error {
+	_, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return errors.New("malformed multihash code")
+	}
+	bz = bz[n:]
+
+	length, n := binary.Uvarint(bz)
+	if n <= 0 {
+		return errors.New("malformed multihash length")
+	}
+	bz = bz[n:]
+
+	switch {
+	case length == 0 || length > MaxMultihashDigestLength:
+		return fmt.Errorf("multihash digest length %d out of range", length)
+	case uint64(len(bz)) != length:
+		return fmt.Errorf("multihash declares %d digest bytes, got %d", length, len(bz))
+	}
+	return nil
+}
@@ -1,12 +1,103 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	ModuleName   = "filehash" // 모듈 이름
 	RouterKey    = ModuleName // Msg 라우팅 시 사용
 	QuerierRoute = ModuleName // Querier 라우팅 시 사용
 	StoreKey     = ModuleName // KVStore key
+
+	// TStoreKey is the transient store key, used for per-block upload counters
+	TStoreKey = "transient_" + ModuleName
+
+	// PortID is the IBC port the module binds to for attestation channels
+	PortID = ModuleName
+	// Version is the application version negotiated on filehash channels
+	Version = "doctorium-filehash-1"
 )
 
+// Store layout (consensus version 2). Every entry of the module store lives
+// under one of the prefixes below; nothing is written to the store root.
 var (
-	FileKeyPrefix = []byte{0x01}
+	FileKeyPrefix      = []byte{0x01} // 0x01 | hash -> FileRecord
+	CreatorIndexPrefix = []byte{0x02} // 0x02 | len(creator) | creator | hash -> []byte{}
+	ParamsKey          = []byte{0x03} // 0x03 -> Params
+	TotalRewardsKey    = []byte{0x04} // 0x04 -> sdk.Coins string, sum of every record's reward
+	OwnershipPrefix    = []byte{0x05} // 0x05 | hash -> OwnershipHistory
+	AnchoredRootPrefix = []byte{0x06} // 0x06 | root -> AnchoredRoot
+	OwnerIndexPrefix   = []byte{0x07} // 0x07 | len(owner) | owner | hash -> []byte{}
+	LegacyEntryPrefix  = []byte{0x08} // 0x08 | v1 key -> LegacyEntry
+
+	// transient store
+	UploadCountPrefix = []byte{0x01} // 0x01 | len(creator) | creator -> uint64
 )
+
+// FileKey returns the store key of the record registered for hash.
+func FileKey(hash string) []byte {
+	return append(append([]byte{}, FileKeyPrefix...), hash...)
+}
+
+// OwnershipHistoryKey returns the store key of the ownership history of hash.
+func OwnershipHistoryKey(hash string) []byte {
+	return append(append([]byte{}, OwnershipPrefix...), hash...)
+}
+
+// AnchoredRootKey returns the store key of the anchored merkle root.
+func AnchoredRootKey(root string) []byte {
+	return append(append([]byte{}, AnchoredRootPrefix...), root...)
+}
+
+// LegacyEntryKey returns the store key under which the v1 entry stored at
+// legacyKey is kept.
+func LegacyEntryKey(legacyKey []byte) []byte {
+	return append(append([]byte{}, LegacyEntryPrefix...), legacyKey...)
+}
+
+// CreatorIndexKeyPrefix returns the prefix under which all hashes registered
+// by creator are indexed.
+func CreatorIndexKeyPrefix(creator sdk.AccAddress) []byte {
+	return append(append([]byte{}, CreatorIndexPrefix...), address.MustLengthPrefix(creator)...)
+}
+
+// CreatorIndexKey returns the creator index key for the given creator and hash.
+func CreatorIndexKey(creator sdk.AccAddress, hash string) []byte {
+	return append(CreatorIndexKeyPrefix(creator), hash...)
+}
+
+// OwnerIndexKeyPrefix returns the prefix under which all hashes currently
+// owned by owner are indexed.
+func OwnerIndexKeyPrefix(owner sdk.AccAddress) []byte {
+	return append(append([]byte{}, OwnerIndexPrefix...), address.MustLengthPrefix(owner)...)
+}
+
+// OwnerIndexKey returns the owner index key for the given owner and hash.
+func OwnerIndexKey(owner sdk.AccAddress, hash string) []byte {
+	return append(OwnerIndexKeyPrefix(owner), hash...)
+}
+
+// UploadCountKey returns the transient store key counting the uploads creator
+// made in the current block.
+func UploadCountKey(creator sdk.AccAddress) []byte {
+	return append(append([]byte{}, UploadCountPrefix...), address.MustLengthPrefix(creator)...)
+}
+
+// ParseCreatorIndexKey splits a full creator index key into the creator and
+// the file hash.
+func ParseCreatorIndexKey(key []byte) (sdk.AccAddress, string) {
+	return parseAddressIndexKey(key[len(CreatorIndexPrefix):])
+}
+
+// ParseOwnerIndexKey splits a full owner index key into the owner and the
+// file hash.
+func ParseOwnerIndexKey(key []byte) (sdk.AccAddress, string) {
+	return parseAddressIndexKey(key[len(OwnerIndexPrefix):])
+}
+
+func parseAddressIndexKey(key []byte) (sdk.AccAddress, string) {
+	addrLen := int(key[0])
+	return sdk.AccAddress(key[1 : 1+addrLen]), string(key[1+addrLen:])
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"errors"
+)
+
+// Validate performs stateless checks on a legacy entry kept by the v2
+// migration.
+func (e *LegacyEntry) Validate() error {
+	if e == nil {
+		return errors.New("legacy entry cannot be nil")
+	}
+	if len(e.Key) == 0 {
+		return errors.New("legacy entry key cannot be empty")
+	}
+	if e.Reason == "" {
+		return errors.New("legacy entry reason cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ensure MsgAnchorMerkleRoot implements the sdk.Msg interface
+var _ sdk.Msg = &MsgAnchorMerkleRoot{}
+
+// Route implements sdk.Msg
+func (msg *MsgAnchorMerkleRoot) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgAnchorMerkleRoot) Type() string {
+	return "AnchorMerkleRoot"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgAnchorMerkleRoot) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if _, err := NormalizeMerkleRoot(msg.TreeAlgorithm, msg.Root); err != nil {
+		return err
+	}
+	if msg.LeafCount == 0 {
+		return fmt.Errorf("leaf count must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgAnchorMerkleRoot) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgAnchorMerkleRoot) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
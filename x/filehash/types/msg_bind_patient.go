@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ensure MsgBindPatient implements the sdk.Msg interface
+var _ sdk.Msg = &MsgBindPatient{}
+
+// Route implements sdk.Msg
+func (msg *MsgBindPatient) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgBindPatient) Type() string {
+	return "BindPatient"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgBindPatient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Patient); err != nil {
+		return fmt.Errorf("invalid patient address: %w", err)
+	}
+	if _, err := NormalizeRegisteredHash(msg.FileHash); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgBindPatient) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgBindPatient) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
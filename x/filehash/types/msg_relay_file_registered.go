@@ -0,0 +1,57 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// Ensure MsgRelayFileRegistered implements the sdk.Msg interface
+var _ sdk.Msg = &MsgRelayFileRegistered{}
+
+// Route implements sdk.Msg
+func (msg *MsgRelayFileRegistered) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgRelayFileRegistered) Type() string {
+	return "RelayFileRegistered"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRelayFileRegistered) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return fmt.Errorf("invalid sender address: %w", err)
+	}
+	if _, err := NormalizeRegisteredHash(msg.FileHash); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(msg.SourceChannel); err != nil {
+		return fmt.Errorf("invalid source channel: %w", err)
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return fmt.Errorf("timeout timestamp cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRelayFileRegistered) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRelayFileRegistered) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
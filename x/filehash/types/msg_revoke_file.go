@@ -0,0 +1,56 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ensure MsgRevokeFile implements the sdk.Msg interface
+var _ sdk.Msg = &MsgRevokeFile{}
+
+// Route implements sdk.Msg
+func (msg *MsgRevokeFile) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgRevokeFile) Type() string {
+	return "RevokeFile"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRevokeFile) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if msg.FileHash == "" {
+		return fmt.Errorf("file hash cannot be empty")
+	}
+	if _, ok := RevocationReason_name[int32(msg.Reason)]; !ok || msg.Reason == RevocationReason_REVOCATION_REASON_UNSPECIFIED {
+		return fmt.Errorf("invalid revocation reason %d", msg.Reason)
+	}
+	if len(msg.Note) > MaxRevocationNoteLength {
+		return fmt.Errorf("revocation note longer than %d bytes", MaxRevocationNoteLength)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgRevokeFile) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRevokeFile) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
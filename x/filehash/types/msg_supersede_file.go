@@ -0,0 +1,54 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ensure MsgSupersedeFile implements the sdk.Msg interface
+var _ sdk.Msg = &MsgSupersedeFile{}
+
+// Route implements sdk.Msg
+func (msg *MsgSupersedeFile) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgSupersedeFile) Type() string {
+	return "SupersedeFile"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSupersedeFile) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if msg.OldFileHash == "" || msg.NewFileHash == "" {
+		return fmt.Errorf("file hashes cannot be empty")
+	}
+	if strings.EqualFold(msg.OldFileHash, msg.NewFileHash) {
+		return fmt.Errorf("a file cannot supersede itself")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgSupersedeFile) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSupersedeFile) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
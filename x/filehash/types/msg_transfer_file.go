@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Ensure MsgTransferFile implements the sdk.Msg interface
+var _ sdk.Msg = &MsgTransferFile{}
+
+// Route implements sdk.Msg
+func (msg *MsgTransferFile) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgTransferFile) Type() string {
+	return "TransferFile"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgTransferFile) ValidateBasic() error {
+	if err := validateTransferParties(msg.Creator, msg.NewOwner); err != nil {
+		return err
+	}
+	if msg.FileHash == "" {
+		return fmt.Errorf("file hash cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgTransferFile) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgTransferFile) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+// validateTransferParties checks the current and the new owner of a transfer.
+func validateTransferParties(creator, newOwner string) error {
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(newOwner); err != nil {
+		return fmt.Errorf("invalid new owner address: %w", err)
+	}
+	if creator == newOwner {
+		return fmt.Errorf("new owner must differ from the current owner")
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxTransferBatchSize is the maximum number of documents a single
+// MsgTransferFiles may move.
+const MaxTransferBatchSize = 100
+
+// Ensure MsgTransferFiles implements the sdk.Msg interface
+var _ sdk.Msg = &MsgTransferFiles{}
+
+// Route implements sdk.Msg
+func (msg *MsgTransferFiles) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgTransferFiles) Type() string {
+	return "TransferFiles"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgTransferFiles) ValidateBasic() error {
+	if err := validateTransferParties(msg.Creator, msg.NewOwner); err != nil {
+		return err
+	}
+	if len(msg.FileHashes) == 0 {
+		return fmt.Errorf("no file hashes given")
+	}
+	if len(msg.FileHashes) > MaxTransferBatchSize {
+		return fmt.Errorf("at most %d files can be transferred at once, got %d", MaxTransferBatchSize, len(msg.FileHashes))
+	}
+
+	seen := make(map[string]struct{}, len(msg.FileHashes))
+	for _, hash := range msg.FileHashes {
+		if hash == "" {
+			return fmt.Errorf("file hash cannot be empty")
+		}
+		key := strings.ToLower(hash)
+		if _, exists := seen[key]; exists {
+			return fmt.Errorf("duplicate file hash %s", hash)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgTransferFiles) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgTransferFiles) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
@@ -28,6 +28,14 @@ func (msg *MsgUploadFile) ValidateBasic() error {
 	if msg.FileHash == "" {
 		return fmt.Errorf("file hash cannot be empty")
 	}
+	if _, err := NormalizeFileHash(msg.HashAlgorithm, msg.FileHash); err != nil {
+		return err
+	}
+	if msg.Patient != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Patient); err != nil {
+			return fmt.Errorf("invalid patient address: %w", err)
+		}
+	}
 	return nil
 }
 
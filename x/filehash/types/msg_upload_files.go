@@ -0,0 +1,71 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxUploadBatchSize is the maximum number of documents a single
+// MsgUploadFiles may register.
+const MaxUploadBatchSize = 500
+
+// Ensure MsgUploadFiles implements the sdk.Msg interface
+var _ sdk.Msg = &MsgUploadFiles{}
+
+// Route implements sdk.Msg
+func (msg *MsgUploadFiles) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg *MsgUploadFiles) Type() string {
+	return "UploadFiles"
+}
+
+// ValidateBasic implements sdk.Msg. Malformed hashes are rejected here;
+// duplicates are only known on chain and are reported per item.
+func (msg *MsgUploadFiles) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+	if len(msg.Files) == 0 {
+		return fmt.Errorf("no files given")
+	}
+	if len(msg.Files) > MaxUploadBatchSize {
+		return fmt.Errorf("at most %d files can be registered at once, got %d", MaxUploadBatchSize, len(msg.Files))
+	}
+	for i, item := range msg.Files {
+		if item.GetFileHash() == "" {
+			return fmt.Errorf("file %d: file hash cannot be empty", i)
+		}
+		if _, err := NormalizeFileHash(item.HashAlgorithm, item.FileHash); err != nil {
+			return fmt.Errorf("file %d: %w", i, err)
+		}
+		if item.Patient != "" {
+			if _, err := sdk.AccAddressFromBech32(item.Patient); err != nil {
+				return fmt.Errorf("file %d: invalid patient address: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg *MsgUploadFiles) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUploadFiles) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
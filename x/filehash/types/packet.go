@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewAttestationRequestPacketData returns the packet data asking the
+// counterparty whether hash is registered.
+func NewAttestationRequestPacketData(hash string) *FilehashPacketData {
+	return &FilehashPacketData{
+		Packet: &FilehashPacketData_AttestationRequest{
+			AttestationRequest: &AttestationRequestPacketData{FileHash: hash},
+		},
+	}
+}
+
+// NewFileRegisteredPacketData returns the packet data announcing record to
+// the counterparty.
+func NewFileRegisteredPacketData(record *FileRecord) *FilehashPacketData {
+	return &FilehashPacketData{
+		Packet: &FilehashPacketData_FileRegistered{
+			FileRegistered: &FileRegisteredPacketData{Record: record},
+		},
+	}
+}
+
+// ValidateBasic performs a stateless check of the packet data.
+func (p *FilehashPacketData) ValidateBasic() error {
+	switch packet := p.Packet.(type) {
+	case *FilehashPacketData_AttestationRequest:
+		if packet.AttestationRequest.GetFileHash() == "" {
+			return sdkerrors.Wrap(ErrInvalidPacket, "empty file hash")
+		}
+	case *FilehashPacketData_FileRegistered:
+		if packet.FileRegistered.GetRecord().GetFileHash() == "" {
+			return sdkerrors.Wrap(ErrInvalidPacket, "missing file record")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidPacket, "unknown packet type %T", packet)
+	}
+	return nil
+}
+
+// GetBytes returns the wire encoding of the packet data. Packet commitments
+// are computed over these bytes; the generated marshaller writes fields in
+// field-number order and the packet has no map fields, so the encoding is
+// deterministic across validators.
+func (p *FilehashPacketData) GetBytes() ([]byte, error) {
+	return p.Marshal()
+}
+
+// UnmarshalPacketData decodes and validates packet data received over a
+// filehash channel.
+func UnmarshalPacketData(bz []byte) (*FilehashPacketData, error) {
+	var data FilehashPacketData
+	if err := data.Unmarshal(bz); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPacket, err.Error())
+	}
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// GetBytes returns the encoding of the acknowledgement result.
+func (a *AttestationAck) GetBytes() ([]byte, error) {
+	return a.Marshal()
+}
+
+// UnmarshalAttestationAck decodes the result of an attestation request
+// acknowledgement.
+func UnmarshalAttestationAck(bz []byte) (*AttestationAck, error) {
+	var ack AttestationAck
+	if err := ack.Unmarshal(bz); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalidPacket, err.Error())
+	}
+	return &ack, nil
+}
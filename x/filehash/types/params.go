@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default parameter values.
+const (
+	DefaultRewardDenom        = "drt"
+	DefaultRewardAmount       = "10"
+	DefaultUploadEnabled      = true
+	DefaultMaxUploadsPerBlock = uint32(0)
+)
+
+// NewParams creates a new Params instance.
+func NewParams(rewardDenom, rewardAmount string, uploadEnabled bool, maxUploadsPerBlock uint32) *Params {
+	return &Params{
+		RewardDenom:        rewardDenom,
+		RewardAmount:       rewardAmount,
+		UploadEnabled:      uploadEnabled,
+		MaxUploadsPerBlock: maxUploadsPerBlock,
+	}
+}
+
+// DefaultParams returns the default filehash parameters.
+func DefaultParams() *Params {
+	return NewParams(DefaultRewardDenom, DefaultRewardAmount, DefaultUploadEnabled, DefaultMaxUploadsPerBlock)
+}
+
+// Validate checks that the parameters are well formed.
+func (p *Params) Validate() error {
+	if p == nil {
+		return fmt.Errorf("params cannot be nil")
+	}
+	if err := sdk.ValidateDenom(p.RewardDenom); err != nil {
+		return fmt.Errorf("invalid reward denom: %w", err)
+	}
+	amount, ok := sdk.NewIntFromString(p.RewardAmount)
+	if !ok {
+		return fmt.Errorf("invalid reward amount %q", p.RewardAmount)
+	}
+	if amount.IsNegative() {
+		return fmt.Errorf("reward amount cannot be negative: %s", amount)
+	}
+
+	seen := make(map[string]struct{}, len(p.BlockedAccounts))
+	for _, acc := range p.BlockedAccounts {
+		if _, err := sdk.AccAddressFromBech32(acc); err != nil {
+			return fmt.Errorf("invalid blocked account %q: %w", acc, err)
+		}
+		if _, ok := seen[acc]; ok {
+			return fmt.Errorf("duplicate blocked account %s", acc)
+		}
+		seen[acc] = struct{}{}
+	}
+	return nil
+}
+
+// RewardCoins returns the coins minted for every registered file.
+func (p *Params) RewardCoins() sdk.Coins {
+	amount, ok := sdk.NewIntFromString(p.RewardAmount)
+	if !ok {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(sdk.NewCoin(p.RewardDenom, amount))
+}